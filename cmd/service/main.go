@@ -1,15 +1,22 @@
 package main
 
 import (
+	"context"
+	"encoding/base64"
 	"log"
 	"net"
+	"net/http"
 
 	"google.golang.org/grpc"
 
 	"github.com/s21platform/staff-service/internal/config"
+	"github.com/s21platform/staff-service/internal/jwtsigner"
+	"github.com/s21platform/staff-service/internal/metrics"
 	"github.com/s21platform/staff-service/internal/middleware"
+	"github.com/s21platform/staff-service/internal/model"
 	"github.com/s21platform/staff-service/internal/repository/postgres"
 	"github.com/s21platform/staff-service/internal/service"
+	"github.com/s21platform/staff-service/internal/sessiongc"
 	staff "github.com/s21platform/staff-service/pkg/staff"
 )
 
@@ -18,19 +25,146 @@ func main() {
 
 	dbRepo := postgres.New(cfg)
 
-	srv := service.New(dbRepo)
+	var metricsClient *metrics.Client
+	if cfg.Metrics.Host != "" {
+		client, err := metrics.New(cfg.Metrics.Host, cfg.Metrics.Port, cfg.Service.Name)
+		if err != nil {
+			log.Printf("failed to create metrics client: %v", err)
+		} else {
+			metricsClient = client
+			defer metricsClient.Close()
+		}
+	}
+
+	sessionTimeouts := model.SessionTimeouts{
+		Idle:     cfg.Session.IdleTimeout,
+		Absolute: cfg.Session.AbsoluteLifetime,
+	}
+
+	sessionLimit := service.SessionLimit{
+		MaxSessions: cfg.Session.MaxSessions,
+		MaxByRole:   cfg.Session.MaxSessionsByRole,
+		Policy:      cfg.Session.LimitPolicy,
+	}
+	if err := sessionLimit.Validate(); err != nil {
+		log.Fatalf("invalid session limit: %v", err)
+	}
+
+	opts := []service.ServiceOption{
+		service.WithLoginThrottle(service.LoginThrottle{
+			MaxLoginFailures: cfg.Login.MaxLoginFailures,
+			MaxIPFailures:    cfg.Login.MaxIPFailures,
+			FailureWindow:    cfg.Login.FailureWindow,
+			BaseLockout:      cfg.Login.BaseLockout,
+			MaxLockout:       cfg.Login.MaxLockout,
+		}),
+		service.WithPasswordPolicy(service.PasswordPolicy{
+			MinLength:     cfg.Password.MinLength,
+			MaxLength:     cfg.Password.MaxLength,
+			RequireUpper:  cfg.Password.RequireUpper,
+			RequireLower:  cfg.Password.RequireLower,
+			RequireDigit:  cfg.Password.RequireDigit,
+			RequireSymbol: cfg.Password.RequireSymbol,
+			RejectLogin:   cfg.Password.RejectLogin,
+			RejectCommon:  cfg.Password.RejectCommon,
+			HistorySize:   cfg.Password.HistorySize,
+			MaxAge:        cfg.Password.MaxAge,
+		}),
+		service.WithPasswordHasher(&service.Hasher{
+			Algorithm:  cfg.Password.HashAlgorithm,
+			BcryptCost: cfg.Password.BcryptCost,
+			Argon2: service.Argon2Params{
+				Memory:      cfg.Password.Argon2Memory,
+				Iterations:  cfg.Password.Argon2Iterations,
+				Parallelism: cfg.Password.Argon2Parallelism,
+				SaltLength:  service.DefaultArgon2Params.SaltLength,
+				KeyLength:   service.DefaultArgon2Params.KeyLength,
+			},
+			MaxConcurrent: cfg.Password.HashConcurrency,
+		}),
+		service.WithPasswordResetTTL(cfg.Password.ResetTTL),
+		service.WithPurgeRetention(cfg.Service.PurgeRetention),
+		service.WithSessionTimeouts(sessionTimeouts),
+		service.WithSessionLimit(sessionLimit),
+		service.WithTOTPIssuer(cfg.Service.TOTPIssuer),
+	}
+	if cfg.Service.TOTPSecretKey != "" {
+		totpSecretKey, err := base64.StdEncoding.DecodeString(cfg.Service.TOTPSecretKey)
+		if err != nil {
+			log.Fatalf("failed to decode totp secret key: %v", err)
+		}
+		totpCipher, err := service.NewTOTPSecretCipher(totpSecretKey)
+		if err != nil {
+			log.Fatalf("failed to create totp secret cipher: %v", err)
+		}
+		opts = append(opts, service.WithTOTPSecretCipher(totpCipher))
+	} else {
+		log.Printf("totp secret key is not set, totp enrollment is disabled")
+	}
+	if cfg.JWT.Enabled {
+		keyEncryptionKey, err := base64.StdEncoding.DecodeString(cfg.JWT.KeyEncryptionKey)
+		if err != nil {
+			log.Fatalf("failed to decode key encryption key: %v", err)
+		}
+		signer, err := jwtsigner.New(dbRepo, cfg.JWT.Algorithm, cfg.JWT.Issuer, cfg.JWT.RotationInterval, cfg.JWT.RefreshInterval, service.DefaultAccessTokenTTL, keyEncryptionKey)
+		if err != nil {
+			log.Fatalf("failed to create token signer: %v", err)
+		}
+		if err := signer.Rotate(context.Background()); err != nil {
+			log.Fatalf("failed to load signing keys: %v", err)
+		}
+		go signer.Run(context.Background())
+
+		// Публикуем открытые ключи для локальной проверки токенов другими сервисами
+		mux := http.NewServeMux()
+		mux.Handle("/.well-known/jwks.json", signer)
+		go func() {
+			log.Printf("Starting jwks server on port %s", cfg.JWT.JWKSPort)
+			if err := http.ListenAndServe(":"+cfg.JWT.JWKSPort, mux); err != nil {
+				log.Fatalf("failed to serve jwks: %v", err)
+			}
+		}()
+
+		opts = append(opts, service.WithTokenSigner(signer))
+	}
+
+	// Загружаем политики доступа из базы и периодически их обновляем.
+	// Без загруженных политик все методы доступны только владельцу
+	policyCache := middleware.NewPolicyCache(dbRepo)
+	if err := policyCache.Reload(context.Background()); err != nil {
+		log.Fatalf("failed to load access policies: %v", err)
+	}
+	go policyCache.Run(context.Background(), cfg.Service.AccessPolicyReloadInterval)
+	opts = append(opts, service.WithPolicyReloader(policyCache))
+
+	// Отметки активности сессий накапливаются в памяти и записываются пакетами
+	activityTracker := middleware.NewActivityTracker(dbRepo)
+	go activityTracker.Run(context.Background(), cfg.Session.ActivityFlushInterval)
+	opts = append(opts, service.WithActivitySource(activityTracker))
+
+	srv := service.New(dbRepo, opts...)
 
 	lis, err := net.Listen("tcp", ":"+cfg.Service.Port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	// Периодически удаляем истекшие сессии, которые никто не предъявит повторно, и устаревшие счетчики неудачных входов
+	sessionCollector, err := sessiongc.New(dbRepo, metricsClient, sessionTimeouts, cfg.Session.ActivityFlushInterval, cfg.Login.FailureWindow, cfg.Session.GCInterval, cfg.Session.GCBatchSize)
+	if err != nil {
+		log.Fatalf("failed to create session collector: %v", err)
+	}
+	go sessionCollector.Run(context.Background())
+
 	// Создаем интерсептор для проверки ролей
-	authInterceptor := middleware.NewAuthInterceptor(dbRepo)
+	authInterceptor := middleware.NewAuthInterceptor(dbRepo, policyCache, activityTracker, sessionTimeouts)
+
+	// Проверяем входящие запросы после авторизации, до вызова обработчика
+	validator := middleware.NewValidator(dbRepo)
 
-	// Создаем gRPC сервер с интерсептором
+	// Создаем gRPC сервер с интерсепторами
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary(), validator.Unary()),
 	)
 
 	staff.RegisterStaffServiceServer(grpcServer, srv)
@@ -2,12 +2,17 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
 	Service  Service
+	JWT      JWT
+	Session  Session
+	Login    Login
+	Password Password
 	Postgres Postgres
 	Metrics  Metrics
 	Logger   Logger
@@ -17,6 +22,63 @@ type Config struct {
 type Service struct {
 	Port string `env:"STAFF_SERVICE_PORT"`
 	Name string `env:"STAFF_SERVICE_NAME"`
+
+	AccessPolicyReloadInterval time.Duration `env:"STAFF_SERVICE_ACCESS_POLICY_RELOAD_INTERVAL" env-default:"1m"` // период перечитывания политик доступа
+	TOTPIssuer                 string        `env:"STAFF_SERVICE_TOTP_ISSUER" env-default:"s21platform staff"`    // название сервиса в приложении-аутентификаторе
+	TOTPSecretKey              string        `env:"STAFF_SERVICE_TOTP_SECRET_KEY"`                                // 32 байта в base64, ключ AES-256-GCM для секретов TOTP в базе
+	PurgeRetention             time.Duration `env:"STAFF_SERVICE_PURGE_RETENTION" env-default:"720h"`             // срок хранения удаленного сотрудника до окончательного удаления
+}
+
+type JWT struct {
+	Enabled          bool          `env:"STAFF_SERVICE_JWT_ENABLED" env-default:"false"`              // выдавать подписанные JWT вместо случайных access токенов
+	Algorithm        string        `env:"STAFF_SERVICE_JWT_ALGORITHM" env-default:"EdDSA"`            // EdDSA или RS256
+	Issuer           string        `env:"STAFF_SERVICE_JWT_ISSUER" env-default:"staff-service"`       // значение claim iss
+	RotationInterval time.Duration `env:"STAFF_SERVICE_JWT_KEY_ROTATION_INTERVAL" env-default:"720h"` // срок, после которого создается новый ключ подписи
+	RefreshInterval  time.Duration `env:"STAFF_SERVICE_JWT_KEY_REFRESH_INTERVAL" env-default:"5m"`    // период перечитывания ключей из базы, за столько же новый ключ публикуется до начала подписи
+	JWKSPort         string        `env:"STAFF_SERVICE_JWKS_PORT" env-default:"8081"`                 // порт HTTP сервера с /.well-known/jwks.json
+	KeyEncryptionKey string        `env:"STAFF_SERVICE_JWT_KEY_ENCRYPTION_KEY"`                       // 32 байта в base64, ключ AES-256-GCM для закрытых ключей подписи в базе
+}
+
+type Session struct {
+	IdleTimeout           time.Duration `env:"STAFF_SERVICE_SESSION_IDLE_TIMEOUT" env-default:"0s"`             // сессия истекает после бездействия, 0 - без ограничения
+	AbsoluteLifetime      time.Duration `env:"STAFF_SERVICE_SESSION_ABSOLUTE_LIFETIME" env-default:"0s"`        // максимальное время жизни сессии с момента входа, 0 - без ограничения
+	ActivityFlushInterval time.Duration `env:"STAFF_SERVICE_SESSION_ACTIVITY_FLUSH_INTERVAL" env-default:"30s"` // период записи отметок активности сессий
+
+	MaxSessions       int         `env:"STAFF_SERVICE_SESSION_MAX_PER_STAFF" env-default:"0"`           // одновременных сессий сотрудника, 0 - без ограничения
+	MaxSessionsByRole map[int]int `env:"STAFF_SERVICE_SESSION_MAX_PER_ROLE"`                            // ограничение для ролей, например 1:10,4:2
+	LimitPolicy       string      `env:"STAFF_SERVICE_SESSION_LIMIT_POLICY" env-default:"evict_oldest"` // reject или evict_oldest
+
+	GCInterval  time.Duration `env:"STAFF_SERVICE_SESSION_GC_INTERVAL" env-default:"10m"`    // период удаления истекших сессий
+	GCBatchSize int           `env:"STAFF_SERVICE_SESSION_GC_BATCH_SIZE" env-default:"1000"` // сессий, удаляемых одним запросом
+}
+
+type Login struct {
+	MaxLoginFailures int           `env:"STAFF_SERVICE_LOGIN_MAX_FAILURES" env-default:"5"`     // неудач подряд для логина до блокировки
+	MaxIPFailures    int           `env:"STAFF_SERVICE_LOGIN_MAX_IP_FAILURES" env-default:"20"` // неудач подряд с адреса до блокировки
+	FailureWindow    time.Duration `env:"STAFF_SERVICE_LOGIN_FAILURE_WINDOW" env-default:"15m"` // неудачи старше не учитываются
+	BaseLockout      time.Duration `env:"STAFF_SERVICE_LOGIN_BASE_LOCKOUT" env-default:"1m"`    // первая блокировка, далее удваивается
+	MaxLockout       time.Duration `env:"STAFF_SERVICE_LOGIN_MAX_LOCKOUT" env-default:"1h"`     // максимальная блокировка
+}
+
+type Password struct {
+	MinLength     int           `env:"STAFF_SERVICE_PASSWORD_MIN_LENGTH" env-default:"12"`        // минимальная длина в символах
+	MaxLength     int           `env:"STAFF_SERVICE_PASSWORD_MAX_LENGTH" env-default:"72"`        // максимальная длина в байтах, не больше 72
+	RequireUpper  bool          `env:"STAFF_SERVICE_PASSWORD_REQUIRE_UPPER" env-default:"true"`   // нужна заглавная буква
+	RequireLower  bool          `env:"STAFF_SERVICE_PASSWORD_REQUIRE_LOWER" env-default:"true"`   // нужна строчная буква
+	RequireDigit  bool          `env:"STAFF_SERVICE_PASSWORD_REQUIRE_DIGIT" env-default:"true"`   // нужна цифра
+	RequireSymbol bool          `env:"STAFF_SERVICE_PASSWORD_REQUIRE_SYMBOL" env-default:"false"` // нужен спецсимвол
+	RejectLogin   bool          `env:"STAFF_SERVICE_PASSWORD_REJECT_LOGIN" env-default:"true"`    // запрет логина в пароле
+	RejectCommon  bool          `env:"STAFF_SERVICE_PASSWORD_REJECT_COMMON" env-default:"true"`   // запрет распространенных паролей
+	HistorySize   int           `env:"STAFF_SERVICE_PASSWORD_HISTORY_SIZE" env-default:"5"`       // сколько последних паролей, включая действующий, нельзя повторять
+	ResetTTL      time.Duration `env:"STAFF_SERVICE_PASSWORD_RESET_TTL" env-default:"1h"`         // срок действия токена сброса пароля
+	MaxAge        time.Duration `env:"STAFF_SERVICE_PASSWORD_MAX_AGE" env-default:"0s"`           // срок действия пароля, 0 - бессрочно
+
+	HashAlgorithm     string `env:"STAFF_SERVICE_PASSWORD_HASH_ALGORITHM" env-default:"argon2id"` // argon2id или bcrypt
+	BcryptCost        int    `env:"STAFF_SERVICE_PASSWORD_BCRYPT_COST" env-default:"12"`          // стоимость bcrypt
+	Argon2Memory      uint32 `env:"STAFF_SERVICE_PASSWORD_ARGON2_MEMORY" env-default:"65536"`     // память Argon2id в КиБ
+	Argon2Iterations  uint32 `env:"STAFF_SERVICE_PASSWORD_ARGON2_ITERATIONS" env-default:"3"`     // число проходов Argon2id
+	Argon2Parallelism uint8  `env:"STAFF_SERVICE_PASSWORD_ARGON2_PARALLELISM" env-default:"4"`    // число потоков Argon2id
+	HashConcurrency   int    `env:"STAFF_SERVICE_PASSWORD_HASH_CONCURRENCY" env-default:"4"`      // одновременных вычислений хеша, 0 - без ограничения
 }
 
 type Postgres struct {
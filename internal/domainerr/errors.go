@@ -0,0 +1,92 @@
+package domainerr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Виды ошибок предметной области
+var (
+	// ErrNotFound возвращается, когда запрашиваемый объект не найден
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists возвращается, когда объект с таким значением уникального поля уже существует
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrFailedPrecondition возвращается, когда состояние связанных объектов не допускает операцию
+	ErrFailedPrecondition = errors.New("failed precondition")
+	// ErrConflict возвращается, когда объект изменен параллельным запросом после чтения
+	ErrConflict = errors.New("conflict")
+)
+
+// Error описывает ошибку предметной области и объект, к которому она относится
+type Error struct {
+	Kind     error  // ErrNotFound, ErrAlreadyExists, ErrFailedPrecondition или ErrConflict
+	Resource string // тип объекта: staff, role, session и т.д.
+	ID       string // идентификатор объекта, если известен
+	Field    string // поле, нарушившее ограничение
+	Reason   string // описание нарушенного условия
+	Err      error  // исходная ошибка
+}
+
+// Error возвращает описание ошибки
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s %s", e.Resource, e.Kind)
+	if e.Field != "" {
+		msg += fmt.Sprintf(" (%s)", e.Field)
+	}
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// Unwrap позволяет проверять вид ошибки и исходную ошибку через errors.Is
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// NotFound создает ошибку отсутствия объекта
+func NotFound(resource, id string) *Error {
+	return &Error{
+		Kind:     ErrNotFound,
+		Resource: resource,
+		ID:       id,
+	}
+}
+
+// AlreadyExists создает ошибку нарушения уникальности поля
+func AlreadyExists(resource, field string, err error) *Error {
+	return &Error{
+		Kind:     ErrAlreadyExists,
+		Resource: resource,
+		Field:    field,
+		Err:      err,
+	}
+}
+
+// FailedPrecondition создает ошибку недопустимого состояния связанных объектов
+func FailedPrecondition(resource, field, reason string, err error) *Error {
+	return &Error{
+		Kind:     ErrFailedPrecondition,
+		Resource: resource,
+		Field:    field,
+		Reason:   reason,
+		Err:      err,
+	}
+}
+
+// Conflict создает ошибку изменения объекта параллельным запросом
+func Conflict(resource, id string) *Error {
+	return &Error{
+		Kind:     ErrConflict,
+		Resource: resource,
+		ID:       id,
+	}
+}
+
+// IsNotFound проверяет, что err или одна из обернутых ошибок означает отсутствие объекта
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
@@ -0,0 +1,420 @@
+package jwtsigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// rsaKeyBits размер генерируемых RSA ключей
+const rsaKeyBits = 2048
+
+// keyEncryptionKeySize размер ключа шифрования закрытых ключей подписи (AES-256)
+const keyEncryptionKeySize = 32
+
+var (
+	// ErrUnsupportedAlgorithm возвращается для неизвестного алгоритма подписи
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	// ErrNoActiveKey возвращается, если ключи подписи еще не загружены
+	ErrNoActiveKey = errors.New("no active signing key")
+	// ErrInvalidKeyEncryptionKey возвращается, если ключ шифрования не задан или имеет неверный размер
+	ErrInvalidKeyEncryptionKey = errors.New("key encryption key must be 32 bytes")
+	// ErrInvalidRefreshInterval возвращается, если ключи перечитываются не чаще, чем ротируются
+	ErrInvalidRefreshInterval = errors.New("refresh interval must be positive and shorter than rotation interval")
+)
+
+// KeyStore определяет хранилище ключей подписи
+type KeyStore interface {
+	SigningKeyListSince(ctx context.Context, since time.Time) ([]*model.SigningKey, error)
+	SigningKeyCreate(ctx context.Context, key *model.SigningKey) error
+}
+
+// Signer подписывает JWT access токены и публикует открытые ключи для их проверки.
+// Время делится на слоты длиной rotationInterval, у каждого слота свой ключ. Ключ следующего
+// слота создается за refreshInterval до его начала, чтобы все реплики успели его опубликовать
+// до первой подписи. Предыдущие ключи остаются опубликованными еще tokenTTL, пока не истекут
+// подписанные ими токены. Закрытые ключи хранятся в базе зашифрованными, открытые - без шифрования
+type Signer struct {
+	store            KeyStore
+	keyCipher        cipher.AEAD
+	algorithm        string
+	issuer           string
+	rotationInterval time.Duration
+	refreshInterval  time.Duration
+	tokenTTL         time.Duration
+
+	mu   sync.RWMutex
+	keys []*signingKey
+}
+
+// signingKey представляет разобранный ключ подписи
+type signingKey struct {
+	id         string
+	algorithm  string
+	privateKey crypto.PrivateKey
+	publicKey  crypto.PublicKey
+	notBefore  time.Time
+}
+
+// accessClaims представляет содержимое JWT access токена
+type accessClaims struct {
+	jwt.RegisteredClaims
+	SessionID              string   `json:"sid"`
+	RoleID                 int      `json:"role_id"`
+	Permissions            []string `json:"permissions"`
+	PasswordChangeRequired bool     `json:"pwd_change,omitempty"`
+}
+
+// New создает Signer для указанного алгоритма. refreshInterval - период перечитывания ключей
+// из базы, keyEncryptionKey - ключ AES-256, которым закрытые ключи подписи шифруются перед сохранением
+func New(store KeyStore, algorithm, issuer string, rotationInterval, refreshInterval, tokenTTL time.Duration, keyEncryptionKey []byte) (*Signer, error) {
+	if algorithm != model.SigningAlgorithmEdDSA && algorithm != model.SigningAlgorithmRS256 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if refreshInterval <= 0 || refreshInterval >= rotationInterval {
+		return nil, ErrInvalidRefreshInterval
+	}
+	if len(keyEncryptionKey) != keyEncryptionKeySize {
+		return nil, ErrInvalidKeyEncryptionKey
+	}
+
+	block, err := aes.NewCipher(keyEncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key cipher: %w", err)
+	}
+	keyCipher, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key cipher: %w", err)
+	}
+
+	return &Signer{
+		store:            store,
+		keyCipher:        keyCipher,
+		algorithm:        algorithm,
+		issuer:           issuer,
+		rotationInterval: rotationInterval,
+		refreshInterval:  refreshInterval,
+		tokenTTL:         tokenTTL,
+	}, nil
+}
+
+// Rotate загружает актуальные ключи и создает недостающие ключи текущего и, если до его начала
+// осталось меньше refreshInterval, следующего слота. Слот ключа уникален в базе, поэтому при
+// одновременной ротации на нескольких репликах сохраняется один ключ, а остальные реплики
+// перечитывают его из базы
+func (s *Signer) Rotate(ctx context.Context) error {
+	now := time.Now()
+
+	currentSlot := now.Truncate(s.rotationInterval)
+	nextSlot := currentSlot.Add(s.rotationInterval)
+	slots := []time.Time{currentSlot}
+	if !now.Before(nextSlot.Add(-s.refreshInterval)) {
+		slots = append(slots, nextSlot)
+	}
+
+	keys, err := s.loadKeys(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	created := false
+	for _, slot := range slots {
+		if hasSlotKey(keys, s.algorithm, slot) {
+			continue
+		}
+
+		storedKey, err := s.generateKey(s.algorithm, slot, now)
+		if err != nil {
+			return fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		if err := s.store.SigningKeyCreate(ctx, storedKey); err != nil {
+			return fmt.Errorf("failed to save signing key: %w", err)
+		}
+		created = true
+	}
+
+	if created {
+		// Ключ слота мог сохранить другой экземпляр, поэтому используем тот, что оказался в базе
+		keys, err = s.loadKeys(ctx, now)
+		if err != nil {
+			return err
+		}
+		for _, slot := range slots {
+			if !hasSlotKey(keys, s.algorithm, slot) {
+				return fmt.Errorf("%w: slot %s", ErrNoActiveKey, slot.Format(time.RFC3339))
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+
+	return nil
+}
+
+// loadKeys загружает из базы ключи, которые еще могут понадобиться для проверки токенов
+func (s *Signer) loadKeys(ctx context.Context, now time.Time) ([]*signingKey, error) {
+	stored, err := s.store.SigningKeyListSince(ctx, now.Add(-(s.rotationInterval + s.tokenTTL)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing keys: %w", err)
+	}
+
+	keys := make([]*signingKey, 0, len(stored))
+	for _, storedKey := range stored {
+		key, err := s.parseKey(storedKey)
+		if err != nil {
+			log.Printf("failed to parse signing key %s: %v", storedKey.ID, err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// hasSlotKey проверяет, что среди ключей есть ключ алгоритма для указанного слота
+func hasSlotKey(keys []*signingKey, algorithm string, slot time.Time) bool {
+	for _, key := range keys {
+		if key.algorithm == algorithm && key.notBefore.Equal(slot) {
+			return true
+		}
+	}
+	return false
+}
+
+// activeKey возвращает ключ, которым подписываются токены в момент now: ключ последнего
+// начавшегося слота. Заранее созданный ключ следующего слота только публикуется
+func (s *Signer) activeKey(now time.Time) *signingKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var active *signingKey
+	for _, key := range s.keys {
+		if key.algorithm != s.algorithm || key.notBefore.After(now) {
+			continue
+		}
+		if active == nil || key.notBefore.After(active.notBefore) {
+			active = key
+		}
+	}
+	return active
+}
+
+// Run периодически выполняет Rotate до отмены контекста
+func (s *Signer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Rotate(ctx); err != nil {
+				log.Printf("failed to rotate signing keys: %v", err)
+			}
+		}
+	}
+}
+
+// Sign подписывает access токен активным ключом
+func (s *Signer) Sign(claims *model.AccessClaims) (string, error) {
+	now := time.Now()
+
+	active := s.activeKey(now)
+	if active == nil {
+		return "", ErrNoActiveKey
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(active.algorithm), accessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Subject:   claims.StaffID.String(),
+			ID:        uuid.New().String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(claims.ExpiresAt),
+		},
+		SessionID:              claims.SessionID.String(),
+		RoleID:                 claims.RoleID,
+		Permissions:            claims.Permissions,
+		PasswordChangeRequired: claims.PasswordChangeRequired,
+	})
+	token.Header["kid"] = active.id
+
+	signed, err := token.SignedString(active.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signed, nil
+}
+
+// PublicKeys возвращает опубликованные ключи проверки подписи
+func (s *Signer) PublicKeys() []model.JSONWebKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	jwks := make([]model.JSONWebKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		jwk, err := key.jwk()
+		if err != nil {
+			log.Printf("failed to encode signing key %s: %v", key.id, err)
+			continue
+		}
+		jwks = append(jwks, jwk)
+	}
+
+	return jwks
+}
+
+// ServeHTTP отдает опубликованные ключи в формате JWKS
+func (s *Signer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	if err := json.NewEncoder(w).Encode(map[string][]model.JSONWebKey{"keys": s.PublicKeys()}); err != nil {
+		log.Printf("failed to write jwks: %v", err)
+	}
+}
+
+// jwk преобразует открытый ключ в формат JWK
+func (k *signingKey) jwk() (model.JSONWebKey, error) {
+	jwk := model.JSONWebKey{
+		KeyID:     k.id,
+		Algorithm: k.algorithm,
+		Use:       "sig",
+	}
+
+	switch publicKey := k.publicKey.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	default:
+		return model.JSONWebKey{}, fmt.Errorf("%w: %T", ErrUnsupportedAlgorithm, publicKey)
+	}
+
+	return jwk, nil
+}
+
+// generateKey создает новую пару ключей для алгоритма и слота, закрытый ключ шифруется
+func (s *Signer) generateKey(algorithm string, slot, now time.Time) (*model.SigningKey, error) {
+	var (
+		privateKey crypto.PrivateKey
+		publicKey  crypto.PublicKey
+	)
+
+	switch algorithm {
+	case model.SigningAlgorithmEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, publicKey = priv, pub
+	case model.SigningAlgorithmRS256:
+		priv, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, publicKey = priv, &priv.PublicKey
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	id := uuid.New().String()
+	encryptedKey, err := s.sealKey(id, privateDER)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SigningKey{
+		ID:         id,
+		Algorithm:  algorithm,
+		PrivateKey: encryptedKey,
+		PublicKey:  publicDER,
+		NotBefore:  slot,
+		CreatedAt:  now,
+	}, nil
+}
+
+// parseKey расшифровывает и разбирает сохраненный ключ подписи
+func (s *Signer) parseKey(stored *model.SigningKey) (*signingKey, error) {
+	privateDER, err := s.openKey(stored.ID, stored.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateDER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(stored.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	return &signingKey{
+		id:         stored.ID,
+		algorithm:  stored.Algorithm,
+		privateKey: privateKey,
+		publicKey:  publicKey,
+		notBefore:  stored.NotBefore,
+	}, nil
+}
+
+// sealKey шифрует закрытый ключ. Идентификатор ключа используется как дополнительные данные,
+// чтобы зашифрованный ключ нельзя было подставить под другим kid
+func (s *Signer) sealKey(id string, privateDER []byte) ([]byte, error) {
+	nonce := make([]byte, s.keyCipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return s.keyCipher.Seal(nonce, nonce, privateDER, []byte(id)), nil
+}
+
+// openKey расшифровывает закрытый ключ, сохраненный sealKey
+func (s *Signer) openKey(id string, encrypted []byte) ([]byte, error) {
+	nonceSize := s.keyCipher.NonceSize()
+	if len(encrypted) < nonceSize {
+		return nil, errors.New("encrypted private key is too short")
+	}
+
+	privateDER, err := s.keyCipher.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+	}
+	return privateDER, nil
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Client отправляет метрики в формате StatsD по UDP.
+// Сервису нужны только счетчики, значения и длительности без буферизации и тегов, а текстовый
+// протокол StatsD укладывается в одну строку на метрику, поэтому отдельная зависимость не подключается.
+// Методы nil-клиента ничего не делают, поэтому метрики можно не настраивать
+type Client struct {
+	conn   net.Conn
+	prefix string
+}
+
+// New создает клиент, отправляющий метрики на host:port с префиксом prefix
+func New(host string, port int, prefix string) (*Client, error) {
+	conn, err := net.Dial("udp", net.JoinHostPort(host, fmt.Sprint(port)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to metrics server: %w", err)
+	}
+
+	return &Client{
+		conn:   conn,
+		prefix: strings.TrimSuffix(prefix, "."),
+	}, nil
+}
+
+// Count увеличивает счетчик name на value
+func (c *Client) Count(name string, value int64) {
+	c.send(fmt.Sprintf("%s:%d|c", c.name(name), value))
+}
+
+// Gauge устанавливает значение name
+func (c *Client) Gauge(name string, value int64) {
+	c.send(fmt.Sprintf("%s:%d|g", c.name(name), value))
+}
+
+// Timing записывает длительность name в миллисекундах
+func (c *Client) Timing(name string, duration time.Duration) {
+	c.send(fmt.Sprintf("%s:%d|ms", c.name(name), duration.Milliseconds()))
+}
+
+// Close закрывает соединение
+func (c *Client) Close() error {
+	if c == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// name добавляет префикс к имени метрики
+func (c *Client) name(name string) string {
+	if c == nil || c.prefix == "" {
+		return name
+	}
+	return c.prefix + "." + name
+}
+
+// send отправляет строку метрики; ошибки UDP не влияют на работу сервиса
+func (c *Client) send(line string) {
+	if c == nil {
+		return
+	}
+	_, _ = c.conn.Write([]byte(line))
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityStore определяет хранилище времени последней активности сессий
+type ActivityStore interface {
+	SessionTouchBatch(ctx context.Context, touches map[uuid.UUID]time.Time) error
+}
+
+// ActivityTracker накапливает в памяти отметки активности сессий и периодически
+// записывает их одним запросом, чтобы не выполнять запись на каждый вызов
+type ActivityTracker struct {
+	store ActivityStore
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]time.Time
+}
+
+// NewActivityTracker создает ActivityTracker без накопленных отметок
+func NewActivityTracker(store ActivityStore) *ActivityTracker {
+	return &ActivityTracker{
+		store:   store,
+		pending: make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Touch отмечает активность сессии
+func (t *ActivityTracker) Touch(sessionID uuid.UUID, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if last, ok := t.pending[sessionID]; !ok || at.After(last) {
+		t.pending[sessionID] = at
+	}
+}
+
+// LastActivity возвращает еще не записанную отметку активности сессии
+func (t *ActivityTracker) LastActivity(sessionID uuid.UUID) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	at, ok := t.pending[sessionID]
+	return at, ok
+}
+
+// Flush записывает накопленные отметки активности.
+// При ошибке отметки возвращаются в очередь и будут записаны при следующем вызове
+func (t *ActivityTracker) Flush(ctx context.Context) error {
+	t.mu.Lock()
+	touches := t.pending
+	t.pending = make(map[uuid.UUID]time.Time)
+	t.mu.Unlock()
+
+	if len(touches) == 0 {
+		return nil
+	}
+
+	if err := t.store.SessionTouchBatch(ctx, touches); err != nil {
+		for sessionID, at := range touches {
+			t.Touch(sessionID, at)
+		}
+		return fmt.Errorf("failed to flush session activity: %w", err)
+	}
+
+	return nil
+}
+
+// Run записывает отметки активности с заданным интервалом до отмены контекста
+func (t *ActivityTracker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Записываем оставшиеся отметки, контекст уже отменен
+			if err := t.Flush(context.Background()); err != nil {
+				log.Printf("failed to flush session activity: %v", err)
+			}
+			return
+		case <-ticker.C:
+			if err := t.Flush(ctx); err != nil {
+				log.Printf("failed to flush session activity: %v", err)
+			}
+		}
+	}
+}
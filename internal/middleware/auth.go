@@ -4,40 +4,49 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/domainerr"
+	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/principal"
 )
 
 const (
-	RoleOwner  = 1
-	RoleAdmin  = 2
-	RoleStaff  = 3
-	RoleViewer = 4
+	RoleOwner  = model.RoleOwner
+	RoleAdmin  = model.RoleAdmin
+	RoleStaff  = model.RoleStaff
+	RoleViewer = model.RoleViewer
 )
 
-// RolePermissions определяет разрешения для каждого метода gRPC
-var RolePermissions = map[string][]int{
-	"/staff.StaffService/Create": {RoleOwner},
-	"/staff.StaffService/Update": {RoleOwner, RoleAdmin},
-	"/staff.StaffService/Delete": {RoleOwner},
-	"/staff.StaffService/List":   {RoleOwner, RoleAdmin, RoleStaff, RoleViewer},
-	"/staff.StaffService/Get":    {RoleOwner, RoleAdmin, RoleStaff, RoleViewer},
+// PasswordChangeMethods методы, доступные сессии, ограниченной сменой пароля
+var PasswordChangeMethods = map[string]bool{
+	"/staff.StaffService/ChangePassword": true,
+	"/staff.StaffService/Logout":         true,
+	"/staff.StaffService/CheckAuth":      true,
 }
 
 type AuthInterceptor struct {
 	sessionManager SessionManager
+	policies       *PolicyCache
+	activity       *ActivityTracker
+	timeouts       model.SessionTimeouts
 }
 
 type SessionManager interface {
-	GetStaffRoleByToken(ctx context.Context, token string) (int, error)
+	GetStaffAccessByToken(ctx context.Context, token string) (*model.StaffAccess, error)
 }
 
-func NewAuthInterceptor(sessionManager SessionManager) *AuthInterceptor {
+func NewAuthInterceptor(sessionManager SessionManager, policies *PolicyCache, activity *ActivityTracker, timeouts model.SessionTimeouts) *AuthInterceptor {
 	return &AuthInterceptor{
 		sessionManager: sessionManager,
+		policies:       policies,
+		activity:       activity,
+		timeouts:       timeouts,
 	}
 }
 
@@ -45,7 +54,10 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		// Пропускаем методы авторизации
 		if info.FullMethod == "/staff.StaffService/Login" ||
-			info.FullMethod == "/staff.StaffService/RefreshToken" {
+			info.FullMethod == "/staff.StaffService/RefreshToken" ||
+			info.FullMethod == "/staff.StaffService/VerifyMFA" ||
+			info.FullMethod == "/staff.StaffService/CompletePasswordReset" ||
+			info.FullMethod == "/staff.StaffService/GetSigningKeys" {
 			return handler(ctx, req)
 		}
 
@@ -61,33 +73,50 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		}
 
 		token := values[0]
-		log.Printf("token: %s", token)
-		// Получаем роль пользователя
-		roleID, err := i.sessionManager.GetStaffRoleByToken(ctx, token)
-		if err != nil {
-			log.Printf("failed to get staff role by token: %v", err)
+		// Получаем роль и разрешения пользователя
+		access, err := i.sessionManager.GetStaffAccessByToken(ctx, token)
+		if domainerr.IsNotFound(err) {
 			return nil, status.Error(codes.Unauthenticated, "invalid token")
 		}
+		if err != nil {
+			log.Printf("failed to get staff access by token: %v", err)
+			return nil, status.Error(codes.Internal, "failed to check token")
+		}
 
-		// Проверяем права доступа
-		allowedRoles, exists := RolePermissions[info.FullMethod]
-		if !exists {
-			// Если метод не указан в разрешениях, разрешаем только Owner
-			allowedRoles = []int{RoleOwner}
+		now := time.Now()
+		lastActivityAt := access.LastActivityAt
+		if pending, ok := i.activity.LastActivity(access.SessionID); ok && pending.After(lastActivityAt) {
+			lastActivityAt = pending
+		}
+		if i.timeouts.Expired(lastActivityAt, access.AuthenticatedAt, now) {
+			return nil, status.Error(codes.Unauthenticated, "session expired")
 		}
 
-		hasPermission := false
-		for _, role := range allowedRoles {
-			if roleID == role {
-				hasPermission = true
-				break
-			}
+		if access.PasswordChangeRequired && !PasswordChangeMethods[info.FullMethod] {
+			return nil, status.Error(codes.PermissionDenied, "password change required")
 		}
 
-		if !hasPermission {
-			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("role %d does not have permission to access %s", roleID, info.FullMethod))
+		if !i.hasAccess(access, info.FullMethod) {
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("role %d does not have permission to access %s", access.RoleID, info.FullMethod))
 		}
 
-		return handler(ctx, req)
+		i.activity.Touch(access.SessionID, now)
+
+		return handler(principal.WithPrincipal(ctx, principal.FromAccess(access)), req)
+	}
+}
+
+// hasAccess проверяет, разрешен ли метод роли или одному из разрешений сотрудника.
+// Owner имеет доступ ко всем методам, чтобы правка политик не могла заблокировать систему
+func (i *AuthInterceptor) hasAccess(access *model.StaffAccess, method string) bool {
+	if access.RoleID == RoleOwner {
+		return true
+	}
+
+	// Метод без политики в access_policies доступен только владельцу
+	policy, ok := i.policies.Get(method)
+	if !ok {
+		return false
 	}
+	return policy.Allows(access)
 }
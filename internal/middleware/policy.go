@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// PolicyStore определяет источник политик доступа к методам
+type PolicyStore interface {
+	AccessPolicyList(ctx context.Context) ([]*model.AccessPolicy, error)
+}
+
+// PolicyCache хранит в памяти политики доступа, загруженные из базы данных
+type PolicyCache struct {
+	store PolicyStore
+
+	mu       sync.RWMutex
+	policies map[string]*model.AccessPolicy
+}
+
+// NewPolicyCache создает пустой кеш политик доступа
+func NewPolicyCache(store PolicyStore) *PolicyCache {
+	return &PolicyCache{
+		store:    store,
+		policies: make(map[string]*model.AccessPolicy),
+	}
+}
+
+// Get возвращает политику доступа к методу, если она загружена
+func (c *PolicyCache) Get(method string) (*model.AccessPolicy, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	policy, ok := c.policies[method]
+	return policy, ok
+}
+
+// Reload перечитывает политики доступа из базы данных
+func (c *PolicyCache) Reload(ctx context.Context) error {
+	policies, err := c.store.AccessPolicyList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load access policies: %w", err)
+	}
+
+	loaded := make(map[string]*model.AccessPolicy, len(policies))
+	for _, policy := range policies {
+		loaded[policy.Method] = policy
+	}
+
+	c.mu.Lock()
+	c.policies = loaded
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Run перечитывает политики с заданным интервалом до отмены контекста.
+// Первая загрузка выполняется через Reload до запуска сервера.
+// При ошибке загрузки продолжают действовать ранее загруженные политики
+func (c *PolicyCache) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Reload(ctx); err != nil {
+				log.Printf("failed to reload access policies: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// RoleStore определяет источник ролей для проверки ссылок на них
+type RoleStore interface {
+	RoleGetByID(ctx context.Context, id int) (*model.Role, error)
+}
+
+// Validator проверяет входящие сообщения по правилам из requestRules до вызова обработчика
+type Validator struct {
+	roles RoleStore
+}
+
+// NewValidator создает Validator
+func NewValidator(roles RoleStore) *Validator {
+	return &Validator{
+		roles: roles,
+	}
+}
+
+// Unary возвращает унарный интерсептор, отклоняющий некорректные запросы
+func (v *Validator) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		violations, err := v.Validate(ctx, msg)
+		if err != nil {
+			log.Printf("failed to validate %s: %v", info.FullMethod, err)
+			return nil, status.Error(codes.Internal, "failed to validate request")
+		}
+		if len(violations) > 0 {
+			return nil, violationsError(violations)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Validate проверяет сообщение и возвращает нарушения для каждого поля.
+// Ошибка возвращается, только если проверку не удалось выполнить
+func (v *Validator) Validate(ctx context.Context, msg proto.Message) ([]*errdetails.BadRequest_FieldViolation, error) {
+	reflectMsg := msg.ProtoReflect()
+	fields, ok := requestRules[reflectMsg.Descriptor().FullName()]
+	if !ok {
+		return nil, nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, field := range fields {
+		value, set := lookupField(reflectMsg, field.path)
+		if !set {
+			if field.required {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       field.path,
+					Description: "is required",
+				})
+			}
+			continue
+		}
+
+		for _, rule := range field.rules {
+			description, err := rule(ctx, v, value)
+			if err != nil {
+				return nil, err
+			}
+			if description != "" {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       field.path,
+					Description: description,
+				})
+				break
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// lookupField находит значение поля по пути вида "policy.role_ids".
+// Поле считается незаполненным, если не задано оно само или одно из родительских сообщений
+func lookupField(msg protoreflect.Message, path string) (protoreflect.Value, bool) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || !msg.Has(fd) {
+			return protoreflect.Value{}, false
+		}
+
+		value := msg.Get(fd)
+		if i == len(names)-1 {
+			return value, true
+		}
+		msg = value.Message()
+	}
+
+	return protoreflect.Value{}, false
+}
+
+// violationsError формирует ошибку InvalidArgument с описанием нарушений по полям
+func violationsError(violations []*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(codes.InvalidArgument, "invalid request").
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return st.Err()
+}
@@ -0,0 +1,262 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/s21platform/staff-service/internal/domainerr"
+	"github.com/s21platform/staff-service/internal/model"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// Ограничения на значения полей запросов
+const (
+	minLoginLength      = 3
+	maxLoginLength      = 64
+	maxRoleNameLength   = 64
+	maxSearchTermLength = 64
+	maxTokenLength      = 512
+	maxSubjectLength    = 255
+	maxStaffPageSize    = 100
+)
+
+var (
+	loginPattern  = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+	methodPattern = regexp.MustCompile(`^/[a-zA-Z0-9_.]+/[a-zA-Z0-9_]+$`)
+)
+
+// rule проверяет заполненное значение поля и возвращает описание нарушения
+// или пустую строку, если значение корректно
+type rule func(ctx context.Context, v *Validator, value protoreflect.Value) (string, error)
+
+// fieldRules правила для поля сообщения
+type fieldRules struct {
+	path     string
+	required bool
+	rules    []rule
+}
+
+// required описывает обязательное поле
+func required(path string, rules ...rule) fieldRules {
+	return fieldRules{path: path, required: true, rules: rules}
+}
+
+// optional описывает поле, которое проверяется только если заполнено
+func optional(path string, rules ...rule) fieldRules {
+	return fieldRules{path: path, rules: rules}
+}
+
+// requestRules правила проверки запросов по полному имени сообщения
+var requestRules = map[protoreflect.FullName][]fieldRules{
+	// Управление персоналом
+	messageName(&staff.GetIn{}): {required("id", isUUID)},
+	messageName(&staff.CreateIn{}): {
+		required("login", isLogin),
+		required("password"),
+		required("role_id", roleExists),
+		optional("permissions.access", each(knownPermission)),
+	},
+	messageName(&staff.UpdateIn{}): {
+		required("id", isUUID),
+		optional("login", isLogin),
+		optional("role_id", roleExists),
+		optional("permissions.access", each(knownPermission)),
+		optional("version", inRange(1, 1<<63-1)),
+	},
+	messageName(&staff.DeleteIn{}):     {required("id", isUUID)},
+	messageName(&staff.DeactivateIn{}): {required("id", isUUID)},
+	messageName(&staff.ReactivateIn{}): {required("id", isUUID)},
+	messageName(&staff.PurgeIn{}):      {required("id", isUUID)},
+	messageName(&staff.ListIn{}): {
+		optional("page", inRange(1, 1<<31-1)),
+		optional("page_size", inRange(1, maxStaffPageSize)),
+		optional("search_term", maxLength(maxSearchTermLength)),
+		optional("role_id", roleExists),
+	},
+
+	// Аутентификация
+	messageName(&staff.LoginIn{}): {
+		required("login", maxLength(maxLoginLength)),
+		required("password"),
+	},
+	messageName(&staff.RefreshTokenIn{}): {required("refresh_token", maxLength(maxTokenLength))},
+	messageName(&staff.ChangePasswordIn{}): {
+		required("old_password"),
+		required("new_password"),
+	},
+
+	// Сессии
+	messageName(&staff.RevokeSessionIn{}):       {required("session_id", isUUID)},
+	messageName(&staff.ListStaffSessionsIn{}):   {required("staff_id", isUUID)},
+	messageName(&staff.RevokeStaffSessionsIn{}): {required("staff_id", isUUID)},
+
+	// Сброс пароля и MFA
+	messageName(&staff.RequestPasswordResetIn{}): {required("staff_id", isUUID)},
+	messageName(&staff.CompletePasswordResetIn{}): {
+		required("reset_token", maxLength(maxTokenLength)),
+		required("new_password"),
+	},
+	messageName(&staff.VerifyMFAIn{}): {
+		required("mfa_token", maxLength(maxTokenLength)),
+		optional("code", maxLength(maxTokenLength)),
+		optional("recovery_code", maxLength(maxTokenLength)),
+	},
+	messageName(&staff.ConfirmTOTPEnrollmentIn{}): {required("code", maxLength(maxTokenLength))},
+	messageName(&staff.DisableTOTPIn{}):           {required("code", maxLength(maxTokenLength))},
+
+	// Блокировки входа
+	messageName(&staff.ClearLoginLockoutIn{}): {
+		required("scope", oneOf(model.LockoutScopeLogin, model.LockoutScopeIP)),
+		required("subject", maxLength(maxSubjectLength)),
+	},
+
+	// Роли
+	messageName(&staff.GetRoleIn{}): {required("id", positive)},
+	messageName(&staff.CreateRoleIn{}): {
+		required("name", maxLength(maxRoleNameLength)),
+	},
+	messageName(&staff.UpdateRoleIn{}): {
+		required("id", positive),
+		required("name", maxLength(maxRoleNameLength)),
+	},
+	messageName(&staff.DeleteRoleIn{}): {required("id", positive)},
+
+	// Политики доступа
+	messageName(&staff.SetAccessPolicyIn{}): {
+		required("policy"),
+		required("policy.method", methodName),
+		optional("policy.role_ids", each(roleExists)),
+		optional("policy.permissions", each(knownPermission)),
+	},
+	messageName(&staff.DeleteAccessPolicyIn{}): {required("method", methodName)},
+
+	// Журнал аудита
+	messageName(&staff.ListAuditEventsIn{}): {
+		optional("actor_id", isUUID),
+		optional("from", inRange(0, 1<<63-1)),
+		optional("to", inRange(0, 1<<63-1)),
+		optional("page_size", inRange(1, model.MaxAuditPageSize)),
+	},
+}
+
+// messageName возвращает полное имя proto-сообщения
+func messageName(msg proto.Message) protoreflect.FullName {
+	return msg.ProtoReflect().Descriptor().FullName()
+}
+
+// ===== Правила =====
+
+// isUUID проверяет, что строка является UUID
+func isUUID(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+	if _, err := uuid.Parse(value.String()); err != nil {
+		return "must be a valid UUID", nil
+	}
+	return "", nil
+}
+
+// isLogin проверяет длину и допустимые символы логина
+func isLogin(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+	login := value.String()
+	if length := utf8.RuneCountInString(login); length < minLoginLength || length > maxLoginLength {
+		return fmt.Sprintf("must be between %d and %d characters long", minLoginLength, maxLoginLength), nil
+	}
+	if !loginPattern.MatchString(login) {
+		return "must contain only latin letters, digits, '.', '_' and '-'", nil
+	}
+	return "", nil
+}
+
+// positive проверяет, что число больше нуля
+func positive(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+	if value.Int() <= 0 {
+		return "must be positive", nil
+	}
+	return "", nil
+}
+
+// methodName проверяет, что строка похожа на полное имя gRPC метода
+func methodName(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+	if !methodPattern.MatchString(value.String()) {
+		return "must be a full method name like /staff.StaffService/Get", nil
+	}
+	return "", nil
+}
+
+// knownPermission проверяет, что разрешение есть в реестре model.KnownPermissions
+func knownPermission(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+	if !model.IsKnownPermission(value.String()) {
+		return fmt.Sprintf("unknown permission %q", value.String()), nil
+	}
+	return "", nil
+}
+
+// roleExists проверяет, что роль с указанным ID существует
+func roleExists(ctx context.Context, v *Validator, value protoreflect.Value) (string, error) {
+	id := value.Int()
+	if id <= 0 {
+		return "must be positive", nil
+	}
+
+	if _, err := v.roles.RoleGetByID(ctx, int(id)); err != nil {
+		if domainerr.IsNotFound(err) {
+			return fmt.Sprintf("role %d does not exist", id), nil
+		}
+		return "", fmt.Errorf("failed to get role: %w", err)
+	}
+	return "", nil
+}
+
+// maxLength ограничивает длину строки в символах
+func maxLength(limit int) rule {
+	return func(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+		if utf8.RuneCountInString(value.String()) > limit {
+			return fmt.Sprintf("must be at most %d characters long", limit), nil
+		}
+		return "", nil
+	}
+}
+
+// inRange ограничивает целое число диапазоном [minValue, maxValue]
+func inRange(minValue, maxValue int64) rule {
+	return func(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+		if n := value.Int(); n < minValue || n > maxValue {
+			return fmt.Sprintf("must be between %d and %d", minValue, maxValue), nil
+		}
+		return "", nil
+	}
+}
+
+// oneOf ограничивает строку перечнем значений
+func oneOf(allowed ...string) rule {
+	return func(_ context.Context, _ *Validator, value protoreflect.Value) (string, error) {
+		for _, candidate := range allowed {
+			if value.String() == candidate {
+				return "", nil
+			}
+		}
+		return fmt.Sprintf("must be one of %v", allowed), nil
+	}
+}
+
+// each применяет правило к каждому элементу повторяющегося поля
+func each(r rule) rule {
+	return func(ctx context.Context, v *Validator, value protoreflect.Value) (string, error) {
+		list := value.List()
+		for i := 0; i < list.Len(); i++ {
+			description, err := r(ctx, v, list.Get(i))
+			if err != nil || description != "" {
+				if description != "" {
+					description = fmt.Sprintf("element %d: %s", i, description)
+				}
+				return description, err
+			}
+		}
+		return "", nil
+	}
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// AccessPolicy описывает, каким ролям и разрешениям доступен метод gRPC
+type AccessPolicy struct {
+	Method      string
+	Roles       []int
+	Permissions []string
+	UpdatedAt   time.Time
+}
+
+// Allows проверяет, разрешает ли политика доступ сотруднику
+func (p *AccessPolicy) Allows(access *StaffAccess) bool {
+	for _, role := range p.Roles {
+		if access.RoleID == role {
+			return true
+		}
+	}
+
+	for _, permission := range p.Permissions {
+		if access.Permissions.Has(permission) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Действия, записываемые в журнал аудита
+const (
+	AuditActionStaffCreate          = "staff.create"
+	AuditActionStaffUpdate          = "staff.update"
+	AuditActionStaffDelete          = "staff.delete"
+	AuditActionStaffDeactivate      = "staff.deactivate"
+	AuditActionStaffReactivate      = "staff.reactivate"
+	AuditActionStaffPurge           = "staff.purge"
+	AuditActionPasswordChange       = "staff.password_change"
+	AuditActionPasswordResetRequest = "staff.password_reset_request"
+	AuditActionPasswordReset        = "staff.password_reset"
+	AuditActionTOTPEnable           = "staff.totp_enable"
+	AuditActionTOTPDisable          = "staff.totp_disable"
+	AuditActionLogin                = "auth.login"
+	AuditActionLogout               = "auth.logout"
+	AuditActionSessionRevoke        = "session.revoke"
+	AuditActionSessionRevokeOthers  = "session.revoke_others"
+	AuditActionSessionRevokeAll     = "session.revoke_all"
+	AuditActionRoleCreate           = "role.create"
+	AuditActionRoleUpdate           = "role.update"
+	AuditActionRoleDelete           = "role.delete"
+	AuditActionAccessPolicySet      = "access_policy.set"
+	AuditActionAccessPolicyDelete   = "access_policy.delete"
+	AuditActionLoginLockoutClear    = "login_lockout.clear"
+)
+
+// Типы объектов, над которыми выполняются действия
+const (
+	AuditTargetStaff        = "staff"
+	AuditTargetRole         = "role"
+	AuditTargetAccessPolicy = "access_policy"
+	AuditTargetSession      = "session"
+	AuditTargetLoginLockout = "login_lockout"
+)
+
+// Размер страницы журнала аудита
+const (
+	DefaultAuditPageSize = 50
+	MaxAuditPageSize     = 500
+)
+
+// AuditChange значение поля до и после изменения
+type AuditChange struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// AuditEvent представляет запись журнала аудита
+type AuditEvent struct {
+	ID         int64
+	ActorID    *uuid.UUID // nil, если действие выполнено без сессии
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    map[string]AuditChange
+	IP         string
+	CreatedAt  time.Time
+}
+
+// AuditEventFilter параметры выборки журнала аудита
+type AuditEventFilter struct {
+	ActorID  *uuid.UUID
+	TargetID string
+	Action   string
+	From     time.Time
+	To       time.Time
+	BeforeID int64 // курсор: только записи с меньшим ID, 0 - с начала
+	Limit    int
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Области учета неудачных попыток входа
+const (
+	LockoutScopeLogin = "login"
+	LockoutScopeIP    = "ip"
+)
+
+// LoginLockout представляет счетчик неудачных попыток входа по логину или адресу
+type LoginLockout struct {
+	Scope         string     `db:"scope"`
+	Subject       string     `db:"subject"`
+	Failures      int        `db:"failures"`
+	LockedUntil   *time.Time `db:"locked_until"`
+	LastFailureAt time.Time  `db:"last_failure_at"`
+}
+
+// IsLocked проверяет, действует ли блокировка в указанный момент
+func (l *LoginLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && l.LockedUntil.After(now)
+}
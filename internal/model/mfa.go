@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFAChallenge представляет незавершенный вход, ожидающий второй фактор.
+// Token заполнен только у только что созданного запроса, в базе хранится его хеш
+type MFAChallenge struct {
+	ID        uuid.UUID `db:"id"`
+	StaffID   uuid.UUID `db:"staff_id"`
+	Token     string    `db:"-"`
+	TokenHash string    `db:"token_hash"`
+	Attempts  int       `db:"attempts"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
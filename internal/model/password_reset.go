@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetToken представляет одноразовый токен сброса пароля, выданный администратором.
+// Token заполнен только у только что созданного токена, в базе хранится его хеш
+type PasswordResetToken struct {
+	ID        uuid.UUID  `db:"id"`
+	StaffID   uuid.UUID  `db:"staff_id"`
+	Token     string     `db:"-"`
+	TokenHash string     `db:"token_hash"`
+	CreatedBy *uuid.UUID `db:"created_by"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
@@ -1,7 +1,35 @@
 package model
 
+// Идентификаторы системных ролей, создаваемых миграцией 0003_seed_roles
+const (
+	RoleOwner  = 1
+	RoleAdmin  = 2
+	RoleStaff  = 3
+	RoleViewer = 4
+)
+
 // Role представляет информацию о роли
 type Role struct {
-	ID   int
-	Name string
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+}
+
+// IsSystemRole проверяет, является ли роль системной
+func IsSystemRole(id int) bool {
+	return id >= RoleOwner && id <= RoleViewer
+}
+
+// RoleLevel возвращает уровень роли в иерархии, чем больше значение, тем выше роль.
+// Пользовательские роли находятся на уровне наблюдателя
+func RoleLevel(id int) int {
+	switch id {
+	case RoleOwner:
+		return 4
+	case RoleAdmin:
+		return 3
+	case RoleStaff:
+		return 2
+	default:
+		return 1
+	}
 }
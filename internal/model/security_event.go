@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Типы событий безопасности
+const (
+	// SecurityEventRefreshTokenReuse повторное предъявление уже обмененного refresh токена
+	SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+)
+
+// SecurityEvent представляет событие безопасности
+type SecurityEvent struct {
+	ID        uuid.UUID
+	StaffID   uuid.UUID
+	Type      string
+	Details   map[string]string
+	CreatedAt time.Time
+}
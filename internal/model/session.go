@@ -6,13 +6,46 @@ import (
 	"github.com/google/uuid"
 )
 
-// Session представляет информацию о сессии
+// Session представляет информацию о сессии.
+// Token и RefreshToken заполнены только у только что созданной сессии,
+// в базе данных хранятся лишь их SHA-256 хеши
 type Session struct {
-	ID             uuid.UUID
-	StaffID        uuid.UUID
-	Token          string
-	RefreshToken   string
-	ExpiresAt      time.Time
-	CreatedAt      time.Time
-	LastActivityAt time.Time
+	ID                     uuid.UUID  `db:"id"`
+	StaffID                uuid.UUID  `db:"staff_id"`
+	FamilyID               uuid.UUID  `db:"family_id"` // общий для всех сессий, полученных обновлением refresh токена
+	Token                  string     `db:"-"`
+	RefreshToken           string     `db:"-"`
+	TokenHash              string     `db:"token_hash"`
+	RefreshTokenHash       string     `db:"refresh_token_hash"`
+	ExpiresAt              time.Time  `db:"expires_at"`               // срок действия access токена
+	RefreshExpiresAt       time.Time  `db:"refresh_expires_at"`       // срок действия refresh токена
+	RotatedAt              *time.Time `db:"rotated_at"`               // момент обмена refresh токена на новую сессию
+	PasswordChangeRequired bool       `db:"password_change_required"` // сессия позволяет только сменить пароль и выйти
+	UserAgent              string     `db:"user_agent"`
+	IP                     string     `db:"ip"`               // адрес клиента при входе
+	AuthenticatedAt        time.Time  `db:"authenticated_at"` // момент входа, общий для семейства
+	CreatedAt              time.Time  `db:"created_at"`
+	LastActivityAt         time.Time  `db:"last_activity_at"`
+}
+
+// SessionTimeouts ограничения времени жизни сессии, нулевое значение отключает ограничение
+type SessionTimeouts struct {
+	Idle     time.Duration // сессия истекает, если не использовалась дольше
+	Absolute time.Duration // максимальное время жизни сессии с момента входа
+}
+
+// Expired проверяет, истекла ли сессия по бездействию или по максимальному времени жизни
+func (t SessionTimeouts) Expired(lastActivityAt, authenticatedAt, now time.Time) bool {
+	if t.Idle > 0 && now.Sub(lastActivityAt) > t.Idle {
+		return true
+	}
+	return t.Absolute > 0 && now.Sub(authenticatedAt) > t.Absolute
+}
+
+// Deadline возвращает момент, позже которого сессию нельзя продлить, или нулевое время
+func (t SessionTimeouts) Deadline(authenticatedAt time.Time) time.Time {
+	if t.Absolute <= 0 {
+		return time.Time{}
+	}
+	return authenticatedAt.Add(t.Absolute)
 }
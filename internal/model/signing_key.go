@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Алгоритмы подписи JWT access токенов
+const (
+	SigningAlgorithmEdDSA = "EdDSA"
+	SigningAlgorithmRS256 = "RS256"
+)
+
+// SigningKey представляет ключ подписи JWT access токенов
+type SigningKey struct {
+	ID         string    `db:"id"`
+	Algorithm  string    `db:"algorithm"`
+	PrivateKey []byte    `db:"private_key"` // PKCS #8, DER, зашифрован AES-256-GCM
+	PublicKey  []byte    `db:"public_key"`
+	NotBefore  time.Time `db:"not_before"` // начало слота ротации, с которого ключ подписывает токены
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// AccessClaims представляет данные, которые включаются в JWT access токен
+type AccessClaims struct {
+	StaffID                uuid.UUID
+	SessionID              uuid.UUID
+	RoleID                 int
+	Permissions            []string
+	ExpiresAt              time.Time
+	PasswordChangeRequired bool // токен действителен только для смены пароля
+}
+
+// JSONWebKey представляет публичный ключ подписи в формате JWK (RFC 7517)
+type JSONWebKey struct {
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
@@ -10,14 +10,57 @@ import (
 
 // Staff представляет информацию о сотруднике
 type Staff struct {
-	ID           uuid.UUID   `db:"id"`
-	Login        string      `db:"login"`
-	PasswordHash string      `db:"password_hash"`
-	RoleID       int         `db:"role_id"`
-	RoleName     string      `db:"role_name"`
-	Permissions  Permissions `db:"permissions"`
-	CreatedAt    time.Time   `db:"created_at"`
-	UpdatedAt    time.Time   `db:"updated_at"`
+	ID                 uuid.UUID   `db:"id"`
+	Login              string      `db:"login"`
+	PasswordHash       string      `db:"password_hash"`
+	RoleID             int         `db:"role_id"`
+	RoleName           string      `db:"role_name"`
+	Permissions        Permissions `db:"permissions"`
+	TOTPSecret         string      `db:"totp_secret"` // зашифрован AES-256-GCM, см. service.WithTOTPSecretCipher
+	TOTPEnabled        bool        `db:"totp_enabled"`
+	TOTPLastStep       int64       `db:"totp_last_step"`
+	MustChangePassword bool        `db:"must_change_password"` // пароль выдан администратором и должен быть сменен
+	PasswordChangedAt  time.Time   `db:"password_changed_at"`
+	IsActive           bool        `db:"is_active"`  // отключенный сотрудник не может войти в систему
+	DeletedAt          *time.Time  `db:"deleted_at"` // время удаления, nil для неудаленных
+	Version            int64       `db:"version"`    // увеличивается при каждом изменении через StaffUpdate
+	CreatedAt          time.Time   `db:"created_at"`
+	UpdatedAt          time.Time   `db:"updated_at"`
+}
+
+// Разрешения, которые могут быть выданы сотруднику в дополнение к роли
+const (
+	PermissionStaffRead   = "staff:read"
+	PermissionStaffWrite  = "staff:write"
+	PermissionStaffDelete = "staff:delete"
+	PermissionRolesRead   = "roles:read"
+	PermissionRolesWrite  = "roles:write"
+	PermissionAuditRead   = "audit:read"
+)
+
+// KnownPermissions реестр разрешений, которые можно выдать сотруднику или указать в политике доступа
+var KnownPermissions = []string{
+	PermissionStaffRead,
+	PermissionStaffWrite,
+	PermissionStaffDelete,
+	PermissionRolesRead,
+	PermissionRolesWrite,
+	PermissionAuditRead,
+}
+
+// IsKnownPermission проверяет, что разрешение есть в реестре
+func IsKnownPermission(permission string) bool {
+	for _, known := range KnownPermissions {
+		if known == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Active проверяет, что учетная запись не отключена и не удалена
+func (s *Staff) Active() bool {
+	return s.IsActive && s.DeletedAt == nil
 }
 
 // Permissions представляет разрешения сотрудника
@@ -25,6 +68,16 @@ type Permissions struct {
 	Access []string `json:"access"`
 }
 
+// Has проверяет, выдано ли сотруднику указанное разрешение
+func (p Permissions) Has(permission string) bool {
+	for _, access := range p.Access {
+		if access == permission {
+			return true
+		}
+	}
+	return false
+}
+
 // Scan реализует интерфейс sql.Scanner для Permissions
 func (p *Permissions) Scan(value interface{}) error {
 	if value == nil {
@@ -49,10 +102,24 @@ func (p Permissions) Value() (driver.Value, error) {
 	return json.Marshal(p)
 }
 
+// StaffAccess представляет данные, необходимые для проверки прав доступа
+type StaffAccess struct {
+	StaffID                uuid.UUID   `db:"staff_id"`
+	Login                  string      `db:"login"`
+	SessionID              uuid.UUID   `db:"session_id"`
+	FamilyID               uuid.UUID   `db:"family_id"`
+	RoleID                 int         `db:"role_id"`
+	Permissions            Permissions `db:"permissions"`
+	PasswordChangeRequired bool        `db:"password_change_required"` // сессия ограничена сменой пароля
+	LastActivityAt         time.Time   `db:"last_activity_at"`
+	AuthenticatedAt        time.Time   `db:"authenticated_at"`
+}
+
 // StaffFilter представляет параметры фильтрации для списка сотрудников
 type StaffFilter struct {
-	Page       int
-	PageSize   int
-	SearchTerm string
-	RoleID     int
+	Page            int
+	PageSize        int
+	SearchTerm      string
+	RoleID          int
+	IncludeInactive bool // включать отключенных и удаленных сотрудников
 }
@@ -0,0 +1,56 @@
+package principal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// Principal представляет сотрудника, от имени которого выполняется запрос
+type Principal struct {
+	StaffID                uuid.UUID
+	Login                  string
+	RoleID                 int
+	Permissions            model.Permissions
+	SessionID              uuid.UUID
+	FamilyID               uuid.UUID
+	PasswordChangeRequired bool // сессия ограничена сменой пароля
+}
+
+// contextKey ключ, под которым Principal хранится в контексте
+type contextKey struct{}
+
+// FromAccess создает Principal по данным проверки доступа
+func FromAccess(access *model.StaffAccess) *Principal {
+	return &Principal{
+		StaffID:                access.StaffID,
+		Login:                  access.Login,
+		RoleID:                 access.RoleID,
+		Permissions:            access.Permissions,
+		SessionID:              access.SessionID,
+		FamilyID:               access.FamilyID,
+		PasswordChangeRequired: access.PasswordChangeRequired,
+	}
+}
+
+// WithPrincipal возвращает контекст, содержащий Principal
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext возвращает Principal из контекста, если запрос авторизован
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// StaffID возвращает ID сотрудника из контекста или nil для неавторизованного запроса
+func StaffID(ctx context.Context) *uuid.UUID {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return &p.StaffID
+}
@@ -2,29 +2,32 @@ package postgres
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 
 	"github.com/s21platform/staff-service/internal/config"
+	"github.com/s21platform/staff-service/internal/domainerr"
 	"github.com/s21platform/staff-service/internal/model"
-)
-
-// Ошибки репозитория
-var (
-	ErrNotFound = errors.New("not found")
-	ErrConflict = errors.New("conflict")
+	"github.com/s21platform/staff-service/internal/repository"
 )
 
 // Repo реализует интерфейс DbRepo для работы с PostgreSQL
 type Repo struct {
 	db *sqlx.DB
+	tx *sqlx.Tx // открытая транзакция для репозитория, созданного в WithTx
 }
 
 // New создает новый экземпляр репозитория
@@ -39,13 +42,82 @@ func New(cfg *config.Config) *Repo {
 	}
 }
 
+// TryAdvisoryLock пытается захватить advisory блокировку Postgres на отдельном соединении.
+// Возвращает функцию освобождения блокировки или nil, если блокировку держит другой процесс
+func (r *Repo) TryAdvisoryLock(ctx context.Context, key int64) (func(), error) {
+	conn, err := r.db.Connx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	var acquired bool
+	if err := conn.GetContext(ctx, &acquired, "SELECT pg_try_advisory_lock($1)", key); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, nil
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			log.Printf("failed to release advisory lock: %v", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+// executor общий набор методов sqlx.DB и sqlx.Tx
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// conn возвращает транзакцию, если репозиторий создан в WithTx, иначе пул соединений
+func (r *Repo) conn() executor {
+	if r.tx != nil {
+		return r.tx
+	}
+	return r.db
+}
+
+// WithTx выполняет fn в транзакции: методы переданного в fn репозитория работают в ней.
+// Вызов у репозитория, уже созданного в WithTx, использует открытую транзакцию
+func (r *Repo) WithTx(ctx context.Context, fn func(repo repository.DbRepo) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(&Repo{db: r.db, tx: tx}); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			log.Printf("failed to rollback transaction: %v", rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // ===== Методы для работы со Staff =====
 
 // StaffGetByID получает информацию о сотруднике по ID
 func (r *Repo) StaffGetByID(ctx context.Context, id uuid.UUID) (*model.Staff, error) {
 	query, args, err := sq.
 		Select("s.id", "s.login", "s.password_hash", "s.role_id", "r.name as role_name",
-			"s.permissions", "s.created_at", "s.updated_at").
+			"s.permissions", "COALESCE(s.totp_secret, '') AS totp_secret", "s.totp_enabled",
+			"s.totp_last_step", "s.must_change_password", "s.password_changed_at",
+			"s.is_active", "s.deleted_at", "s.version", "s.created_at", "s.updated_at").
 		From("staff s").
 		LeftJoin("roles r ON s.role_id = r.id").
 		Where(sq.Eq{"s.id": id}).
@@ -57,10 +129,10 @@ func (r *Repo) StaffGetByID(ctx context.Context, id uuid.UUID) (*model.Staff, er
 	}
 
 	staff := &model.Staff{}
-	err = r.db.GetContext(ctx, staff, query, args...)
+	err = r.conn().GetContext(ctx, staff, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
+			return nil, domainerr.NotFound("staff", id.String())
 		}
 		return nil, fmt.Errorf("failed to get staff: %w", err)
 	}
@@ -72,7 +144,9 @@ func (r *Repo) StaffGetByID(ctx context.Context, id uuid.UUID) (*model.Staff, er
 func (r *Repo) StaffGetByLogin(ctx context.Context, login string) (*model.Staff, error) {
 	query, args, err := sq.
 		Select("s.id", "s.login", "s.password_hash", "s.role_id", "r.name as role_name",
-			"s.permissions", "s.created_at", "s.updated_at").
+			"s.permissions", "COALESCE(s.totp_secret, '') AS totp_secret", "s.totp_enabled",
+			"s.totp_last_step", "s.must_change_password", "s.password_changed_at",
+			"s.is_active", "s.deleted_at", "s.version", "s.created_at", "s.updated_at").
 		From("staff s").
 		LeftJoin("roles r ON s.role_id = r.id").
 		Where(sq.Eq{"s.login": login}).
@@ -84,10 +158,10 @@ func (r *Repo) StaffGetByLogin(ctx context.Context, login string) (*model.Staff,
 	}
 
 	staff := &model.Staff{}
-	err = r.db.GetContext(ctx, staff, query, args...)
+	err = r.conn().GetContext(ctx, staff, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
+			return nil, domainerr.NotFound("staff", "")
 		}
 		return nil, fmt.Errorf("failed to get staff: %w", err)
 	}
@@ -99,8 +173,10 @@ func (r *Repo) StaffGetByLogin(ctx context.Context, login string) (*model.Staff,
 func (r *Repo) StaffCreate(ctx context.Context, staff *model.Staff) error {
 	query, args, err := sq.
 		Insert("staff").
-		Columns("id", "login", "password_hash", "role_id", "created_at", "updated_at").
-		Values(staff.ID, staff.Login, staff.PasswordHash, staff.RoleID, staff.CreatedAt, staff.UpdatedAt).
+		Columns("id", "login", "password_hash", "role_id", "permissions", "must_change_password",
+			"password_changed_at", "is_active", "deleted_at", "version", "created_at", "updated_at").
+		Values(staff.ID, staff.Login, staff.PasswordHash, staff.RoleID, staff.Permissions, staff.MustChangePassword,
+			staff.PasswordChangedAt, staff.IsActive, staff.DeletedAt, staff.Version, staff.CreatedAt, staff.UpdatedAt).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -108,24 +184,124 @@ func (r *Repo) StaffCreate(ctx context.Context, staff *model.Staff) error {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	log.Printf("query: %s, args: %v", query, args)
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to create staff: %w", err)
+		return fmt.Errorf("failed to create staff: %w", translateError(err, "staff", "staff"))
 	}
 
 	return nil
 }
 
-// StaffUpdate обновляет информацию о сотруднике
+// StaffUpdate обновляет информацию о сотруднике, если запись не изменилась с момента чтения:
+// версия в базе должна совпадать с staff.Version. После обновления staff.Version увеличивается
 func (r *Repo) StaffUpdate(ctx context.Context, staff *model.Staff) error {
 	query, args, err := sq.
 		Update("staff").
 		Set("login", staff.Login).
 		Set("password_hash", staff.PasswordHash).
 		Set("role_id", staff.RoleID).
+		Set("permissions", staff.Permissions).
+		Set("must_change_password", staff.MustChangePassword).
+		Set("password_changed_at", staff.PasswordChangedAt).
+		Set("is_active", staff.IsActive).
+		Set("deleted_at", staff.DeletedAt).
 		Set("updated_at", staff.UpdatedAt).
-		Where(sq.Eq{"id": staff.ID}).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": staff.ID, "version": staff.Version}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update staff: %w", translateError(err, "staff", "staff"))
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return r.staffUpdateConflict(ctx, staff.ID)
+	}
+
+	staff.Version++
+	return nil
+}
+
+// staffUpdateConflict определяет, почему запись сотрудника не обновилась: она удалена
+// или изменена параллельным запросом
+func (r *Repo) staffUpdateConflict(ctx context.Context, id uuid.UUID) error {
+	query, args, err := sq.
+		Select("version").
+		From("staff").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var version int64
+	err = r.conn().GetContext(ctx, &version, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domainerr.NotFound("staff", id.String())
+		}
+		return fmt.Errorf("failed to get staff version: %w", err)
+	}
+
+	return domainerr.Conflict("staff", id.String())
+}
+
+// StaffUpdatePasswordHash заменяет хеш пароля, если он не изменился с момента чтения
+func (r *Repo) StaffUpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) error {
+	query, args, err := sq.
+		Update("staff").
+		Set("password_hash", newHash).
+		Where(sq.Eq{"id": id, "password_hash": oldHash}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update password hash: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return domainerr.NotFound("staff", id.String())
+	}
+
+	return nil
+}
+
+// StaffSetTOTP сохраняет секрет TOTP и признак его подключения
+func (r *Repo) StaffSetTOTP(ctx context.Context, id uuid.UUID, secret string, enabled bool) error {
+	var secretValue interface{}
+	if secret != "" {
+		secretValue = secret
+	}
+
+	query, args, err := sq.
+		Update("staff").
+		Set("totp_secret", secretValue).
+		Set("totp_enabled", enabled).
+		Set("totp_last_step", 0).
+		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -133,9 +309,9 @@ func (r *Repo) StaffUpdate(ctx context.Context, staff *model.Staff) error {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to update staff: %w", err)
+		return fmt.Errorf("failed to update totp: %w", err)
 	}
 
 	rows, err := result.RowsAffected()
@@ -144,7 +320,57 @@ func (r *Repo) StaffUpdate(ctx context.Context, staff *model.Staff) error {
 	}
 
 	if rows == 0 {
-		return ErrNotFound
+		return domainerr.NotFound("staff", id.String())
+	}
+
+	return nil
+}
+
+// StaffAdvanceTOTPStep запоминает использованный шаг TOTP.
+// Возвращает false, если код этого или более позднего шага уже был принят
+func (r *Repo) StaffAdvanceTOTPStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	query, args, err := sq.
+		Update("staff").
+		Set("totp_last_step", step).
+		Where(sq.Eq{"id": id}).
+		Where(sq.Lt{"totp_last_step": step}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update totp step: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// StaffReplaceTOTPSecret заменяет сохраненный секрет TOTP, если он не изменился с момента чтения.
+// Признак подключения и последний принятый шаг не меняются
+func (r *Repo) StaffReplaceTOTPSecret(ctx context.Context, id uuid.UUID, oldSecret, newSecret string) error {
+	query, args, err := sq.
+		Update("staff").
+		Set("totp_secret", newSecret).
+		Where(sq.Eq{"id": id, "totp_secret": oldSecret}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to replace totp secret: %w", err)
 	}
 
 	return nil
@@ -162,9 +388,9 @@ func (r *Repo) StaffDelete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to delete staff: %w", err)
+		return fmt.Errorf("failed to delete staff: %w", translateError(err, "staff", "staff"))
 	}
 
 	rows, err := result.RowsAffected()
@@ -173,18 +399,45 @@ func (r *Repo) StaffDelete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if rows == 0 {
-		return ErrNotFound
+		return domainerr.NotFound("staff", id.String())
 	}
 
 	return nil
 }
 
+// StaffLockActiveByRole блокирует до конца транзакции записи активных сотрудников с ролью
+// и возвращает их ID
+func (r *Repo) StaffLockActiveByRole(ctx context.Context, roleID int) ([]uuid.UUID, error) {
+	query, args, err := sq.
+		Select("id").
+		From("staff").
+		Where(sq.Eq{"role_id": roleID, "is_active": true, "deleted_at": nil}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var ids []uuid.UUID
+	err = r.conn().SelectContext(ctx, &ids, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock staff by role: %w", err)
+	}
+
+	return ids, nil
+}
+
 // StaffList получает список сотрудников с фильтрацией и пагинацией
 func (r *Repo) StaffList(ctx context.Context, filter *model.StaffFilter) ([]*model.Staff, int, error) {
 	// Построение базового запроса
 	baseQuery := sq.
 		Select("s.id", "s.login", "s.password_hash", "s.role_id", "r.name as role_name",
-			"s.permissions", "s.created_at", "s.updated_at").
+			"s.permissions", "COALESCE(s.totp_secret, '') AS totp_secret", "s.totp_enabled",
+			"s.totp_last_step", "s.must_change_password", "s.password_changed_at",
+			"s.is_active", "s.deleted_at", "s.version", "s.created_at", "s.updated_at").
 		From("staff s").
 		LeftJoin("roles r ON s.role_id = r.id").
 		PlaceholderFormat(sq.Dollar)
@@ -196,6 +449,9 @@ func (r *Repo) StaffList(ctx context.Context, filter *model.StaffFilter) ([]*mod
 	if filter.RoleID != 0 {
 		baseQuery = baseQuery.Where(sq.Eq{"s.role_id": filter.RoleID})
 	}
+	if !filter.IncludeInactive {
+		baseQuery = baseQuery.Where(sq.Eq{"s.is_active": true, "s.deleted_at": nil})
+	}
 
 	// Получение общего количества записей
 	countQuery := baseQuery.Column("COUNT(*) OVER()")
@@ -210,7 +466,7 @@ func (r *Repo) StaffList(ctx context.Context, filter *model.StaffFilter) ([]*mod
 	}
 
 	var staffList []staffWithCount
-	err = r.db.SelectContext(ctx, &staffList, query, args...)
+	err = r.conn().SelectContext(ctx, &staffList, query, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get staff list: %w", err)
 	}
@@ -231,7 +487,7 @@ func (r *Repo) StaffList(ctx context.Context, filter *model.StaffFilter) ([]*mod
 	}
 
 	var result []*model.Staff
-	err = r.db.SelectContext(ctx, &result, query, args...)
+	err = r.conn().SelectContext(ctx, &result, query, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get staff list: %w", err)
 	}
@@ -239,89 +495,94 @@ func (r *Repo) StaffList(ctx context.Context, filter *model.StaffFilter) ([]*mod
 	return result, total, nil
 }
 
-// ===== Методы для работы с Session =====
+// ===== Методы для работы с PasswordHistory =====
 
-// SessionCreate создает новую сессию
-func (r *Repo) SessionCreate(ctx context.Context, session *model.Session) error {
+// PasswordHistoryList получает хеши последних паролей сотрудника, начиная с самого нового
+func (r *Repo) PasswordHistoryList(ctx context.Context, staffID uuid.UUID, limit int) ([]string, error) {
 	query, args, err := sq.
-		Insert("sessions").
-		Columns("id", "staff_id", "token", "refresh_token", "expires_at",
-			"created_at", "last_activity_at").
-		Values(session.ID, session.StaffID, session.Token, session.RefreshToken,
-			session.ExpiresAt, session.CreatedAt, session.LastActivityAt).
+		Select("password_hash").
+		From("password_history").
+		Where(sq.Eq{"staff_id": staffID}).
+		OrderBy("created_at DESC").
+		Limit(uint64(limit)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("failed to build query: %w", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	var hashes []string
+	err = r.conn().SelectContext(ctx, &hashes, query, args...)
 	if err != nil {
-		log.Printf("failed to create session (in repo): %v", err)
-		return fmt.Errorf("failed to create session: %w", err)
+		return nil, fmt.Errorf("failed to get password history: %w", err)
 	}
 
-	return nil
+	return hashes, nil
 }
 
-// SessionGetByToken получает сессию по токену
-func (r *Repo) SessionGetByToken(ctx context.Context, token string) (*model.Session, error) {
+// PasswordHistoryAdd сохраняет хеш прежнего пароля и оставляет в истории только keep последних
+func (r *Repo) PasswordHistoryAdd(ctx context.Context, staffID uuid.UUID, passwordHash string, keep int) error {
 	query, args, err := sq.
-		Select("id", "staff_id", "token", "refresh_token", "expires_at",
-			"created_at", "last_activity_at").
-		From("sessions").
-		Where(sq.Eq{"token": token}).
+		Insert("password_history").
+		Columns("id", "staff_id", "password_hash", "created_at").
+		Values(uuid.New(), staffID, passwordHash, time.Now()).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to build query: %w", err)
+		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	session := &model.Session{}
-	err = r.db.GetContext(ctx, session, query, args...)
+	_, err = r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
-		}
-		return nil, fmt.Errorf("failed to get session: %w", err)
+		return fmt.Errorf("failed to add password history: %w", err)
 	}
 
-	return session, nil
+	prune := `
+		DELETE FROM password_history
+		WHERE staff_id = $1 AND id NOT IN (
+			SELECT id FROM password_history
+			WHERE staff_id = $1
+			ORDER BY created_at DESC
+			LIMIT $2
+		)
+	`
+	_, err = r.conn().ExecContext(ctx, prune, staffID, keep)
+	if err != nil {
+		return fmt.Errorf("failed to prune password history: %w", err)
+	}
+
+	return nil
 }
 
-// SessionGetByRefreshToken получает сессию по refresh токену
-func (r *Repo) SessionGetByRefreshToken(ctx context.Context, refreshToken string) (*model.Session, error) {
+// ===== Методы для работы с PasswordResetToken =====
+
+// PasswordResetTokenCreate сохраняет токен сброса пароля, сохраняя его хеш.
+// Ранее выданные токены сотрудника аннулируются
+func (r *Repo) PasswordResetTokenCreate(ctx context.Context, resetToken *model.PasswordResetToken) error {
+	resetToken.TokenHash = hashToken(resetToken.Token)
+
 	query, args, err := sq.
-		Select("id", "staff_id", "token", "refresh_token", "expires_at",
-			"created_at", "last_activity_at").
-		From("sessions").
-		Where(sq.Eq{"refresh_token": refreshToken}).
+		Delete("password_reset_tokens").
+		Where(sq.Eq{"staff_id": resetToken.StaffID}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to build query: %w", err)
+		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	session := &model.Session{}
-	err = r.db.GetContext(ctx, session, query, args...)
+	_, err = r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
-		}
-		return nil, fmt.Errorf("failed to get session: %w", err)
+		return fmt.Errorf("failed to delete password reset tokens: %w", err)
 	}
 
-	return session, nil
-}
-
-// SessionDelete удаляет сессию по токену
-func (r *Repo) SessionDelete(ctx context.Context, token string) error {
-	query, args, err := sq.
-		Delete("sessions").
-		Where(sq.Eq{"token": token}).
+	query, args, err = sq.
+		Insert("password_reset_tokens").
+		Columns("id", "staff_id", "token_hash", "created_by", "expires_at", "created_at").
+		Values(resetToken.ID, resetToken.StaffID, resetToken.TokenHash, resetToken.CreatedBy,
+			resetToken.ExpiresAt, resetToken.CreatedAt).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -329,75 +590,104 @@ func (r *Repo) SessionDelete(ctx context.Context, token string) error {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to delete session: %w", err)
+		return fmt.Errorf("failed to create password reset token: %w", err)
 	}
 
 	return nil
 }
 
-// SessionDeleteAllForStaff удаляет все сессии сотрудника
-func (r *Repo) SessionDeleteAllForStaff(ctx context.Context, staffID uuid.UUID) error {
+// PasswordResetTokenGetByToken получает токен сброса пароля
+func (r *Repo) PasswordResetTokenGetByToken(ctx context.Context, token string) (*model.PasswordResetToken, error) {
 	query, args, err := sq.
-		Delete("sessions").
-		Where(sq.Eq{"staff_id": staffID}).
+		Select("id", "staff_id", "token_hash", "created_by", "expires_at", "created_at").
+		From("password_reset_tokens").
+		Where(sq.Eq{"token_hash": hashToken(token)}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("failed to build query: %w", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	resetToken := &model.PasswordResetToken{}
+	err = r.conn().GetContext(ctx, resetToken, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to delete sessions: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerr.NotFound("password_reset_token", "")
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
 	}
 
-	return nil
+	return resetToken, nil
 }
 
-// SessionUpdateTokens обновляет токены сессии
-func (r *Repo) SessionUpdateTokens(ctx context.Context, session *model.Session) error {
+// PasswordResetTokenDelete удаляет токен сброса пароля.
+// Возвращает false, если токен уже был использован или удален
+func (r *Repo) PasswordResetTokenDelete(ctx context.Context, id uuid.UUID) (bool, error) {
 	query, args, err := sq.
-		Update("sessions").
-		Set("token", session.Token).
-		Set("refresh_token", session.RefreshToken).
-		Set("expires_at", session.ExpiresAt).
-		Set("last_activity_at", session.LastActivityAt).
-		Where(sq.Eq{"id": session.ID}).
+		Delete("password_reset_tokens").
+		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("failed to build query: %w", err)
+		return false, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to update session: %w", err)
+		return false, fmt.Errorf("failed to delete password reset token: %w", err)
 	}
 
 	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get affected rows: %w", err)
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
 	}
 
-	if rows == 0 {
-		return ErrNotFound
+	return rows > 0, nil
+}
+
+// ===== Методы для работы с Session =====
+
+// SessionCreate создает новую сессию, сохраняя хеши ее токенов
+func (r *Repo) SessionCreate(ctx context.Context, session *model.Session) error {
+	session.TokenHash = hashToken(session.Token)
+	session.RefreshTokenHash = hashToken(session.RefreshToken)
+
+	query, args, err := sq.
+		Insert("sessions").
+		Columns("id", "staff_id", "family_id", "token_hash", "refresh_token_hash", "expires_at",
+			"refresh_expires_at", "password_change_required", "user_agent", "ip", "authenticated_at", "created_at",
+			"last_activity_at").
+		Values(session.ID, session.StaffID, session.FamilyID, session.TokenHash, session.RefreshTokenHash,
+			session.ExpiresAt, session.RefreshExpiresAt, session.PasswordChangeRequired, session.UserAgent,
+			session.IP, session.AuthenticatedAt, session.CreatedAt, session.LastActivityAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("failed to create session (in repo): %v", err)
+		return fmt.Errorf("failed to create session: %w", err)
 	}
 
 	return nil
 }
 
-// ===== Методы для работы с Role =====
-
-// RoleGetByID получает роль по ID
-func (r *Repo) RoleGetByID(ctx context.Context, id int) (*model.Role, error) {
+// SessionGetByRefreshToken получает сессию по refresh токену
+func (r *Repo) SessionGetByRefreshToken(ctx context.Context, refreshToken string) (*model.Session, error) {
 	query, args, err := sq.
-		Select("id", "name").
-		From("roles").
-		Where(sq.Eq{"id": id}).
+		Select("id", "staff_id", "family_id", "token_hash", "refresh_token_hash", "expires_at",
+			"refresh_expires_at", "rotated_at", "password_change_required", "user_agent", "ip",
+			"authenticated_at", "created_at", "last_activity_at").
+		From("sessions").
+		Where(sq.Eq{"refresh_token_hash": hashToken(refreshToken)}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
@@ -405,57 +695,1119 @@ func (r *Repo) RoleGetByID(ctx context.Context, id int) (*model.Role, error) {
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	role := &model.Role{}
-	err = r.db.GetContext(ctx, role, query, args...)
+	session := &model.Session{}
+	err = r.conn().GetContext(ctx, session, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
+			return nil, domainerr.NotFound("session", "")
 		}
-		return nil, fmt.Errorf("failed to get role: %w", err)
+		return nil, fmt.Errorf("failed to get session: %w", err)
 	}
 
-	return role, nil
+	return session, nil
 }
 
-// RoleList получает список всех ролей
-func (r *Repo) RoleList(ctx context.Context) ([]*model.Role, error) {
+// SessionDeleteByID удаляет сессию по ID
+func (r *Repo) SessionDeleteByID(ctx context.Context, id uuid.UUID) error {
 	query, args, err := sq.
-		Select("id", "name").
-		From("roles").
-		OrderBy("id").
+		Delete("sessions").
+		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to build query: %w", err)
+		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	var roles []*model.Role
-	err = r.db.SelectContext(ctx, &roles, query, args...)
+	_, err = r.conn().ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get roles: %w", err)
+		return fmt.Errorf("failed to delete session: %w", err)
 	}
 
-	return roles, nil
+	return nil
 }
 
-// GetStaffRoleByToken получает роль сотрудника по токену сессии
-func (r *Repo) GetStaffRoleByToken(ctx context.Context, token string) (int, error) {
-	query := `
-		SELECT s.role_id
-		FROM staff s
-		JOIN sessions sess ON sess.staff_id = s.id
-		WHERE sess.token = $1 AND sess.expires_at > NOW()
-	`
-
-	var roleID int
-	err := r.db.GetContext(ctx, &roleID, query, token)
-	if err != nil {
+// SessionDeleteAllForStaff удаляет все сессии сотрудника
+func (r *Repo) SessionDeleteAllForStaff(ctx context.Context, staffID uuid.UUID) error {
+	query, args, err := sq.
+		Delete("sessions").
+		Where(sq.Eq{"staff_id": staffID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	return nil
+}
+
+// SessionLockStaff блокирует запись сотрудника до конца транзакции,
+// чтобы параллельные входы одного сотрудника выполнялись по очереди
+func (r *Repo) SessionLockStaff(ctx context.Context, staffID uuid.UUID) error {
+	query, args, err := sq.
+		Select("id").
+		From("staff").
+		Where(sq.Eq{"id": staffID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var id uuid.UUID
+	err = r.conn().GetContext(ctx, &id, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domainerr.NotFound("staff", staffID.String())
+		}
+		return fmt.Errorf("failed to lock staff: %w", err)
+	}
+
+	return nil
+}
+
+// SessionListForStaff получает активные сессии сотрудника, начиная с последней использованной
+func (r *Repo) SessionListForStaff(ctx context.Context, staffID uuid.UUID) ([]*model.Session, error) {
+	query, args, err := sq.
+		Select("id", "staff_id", "family_id", "token_hash", "refresh_token_hash", "expires_at",
+			"refresh_expires_at", "rotated_at", "password_change_required", "user_agent", "ip",
+			"authenticated_at", "created_at", "last_activity_at").
+		From("sessions").
+		Where(sq.Eq{"staff_id": staffID, "rotated_at": nil}).
+		Where("refresh_expires_at > NOW()").
+		OrderBy("last_activity_at DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var sessions []*model.Session
+	err = r.conn().SelectContext(ctx, &sessions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// SessionRevokeForStaff удаляет сессию сотрудника вместе с ее семейством.
+// Возвращает false, если у сотрудника нет такой сессии
+func (r *Repo) SessionRevokeForStaff(ctx context.Context, staffID, id uuid.UUID) (bool, error) {
+	query := `
+		DELETE FROM sessions
+		WHERE staff_id = $1
+		  AND family_id IN (SELECT family_id FROM sessions WHERE id = $2 AND staff_id = $1)
+	`
+
+	result, err := r.conn().ExecContext(ctx, query, staffID, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// SessionDeleteOthersForStaff удаляет все сессии сотрудника, кроме семейства keepFamilyID.
+// Возвращает количество завершенных активных сессий
+func (r *Repo) SessionDeleteOthersForStaff(ctx context.Context, staffID, keepFamilyID uuid.UUID) (int, error) {
+	query := `
+		WITH deleted AS (
+			DELETE FROM sessions
+			WHERE staff_id = $1 AND family_id <> $2
+			RETURNING rotated_at
+		)
+		SELECT COUNT(*) FROM deleted WHERE rotated_at IS NULL
+	`
+
+	var count int
+	err := r.conn().GetContext(ctx, &count, query, staffID, keepFamilyID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	return count, nil
+}
+
+// SessionTouchBatch обновляет время последней активности нескольких сессий одним запросом
+func (r *Repo) SessionTouchBatch(ctx context.Context, touches map[uuid.UUID]time.Time) error {
+	if len(touches) == 0 {
+		return nil
+	}
+
+	ids := make(pq.StringArray, 0, len(touches))
+	times := make(pq.Float64Array, 0, len(touches))
+	for id, at := range touches {
+		ids = append(ids, id.String())
+		times = append(times, float64(at.UnixMicro())/1e6)
+	}
+
+	query := `
+		UPDATE sessions s
+		SET last_activity_at = t.at
+		FROM (SELECT UNNEST($1::uuid[]) AS id, TO_TIMESTAMP(UNNEST($2::float8[])) AS at) t
+		WHERE s.id = t.id AND s.last_activity_at < t.at
+	`
+
+	_, err := r.conn().ExecContext(ctx, query, ids, times)
+	if err != nil {
+		return fmt.Errorf("failed to update session activity: %w", err)
+	}
+
+	return nil
+}
+
+// SessionDeleteExpired удаляет не более limit сессий, refresh токен которых истек до expiredBefore,
+// последняя активность в которых была до idleBefore или вход в которые выполнен до authenticatedBefore.
+// Нулевые idleBefore и authenticatedBefore не учитываются. Возвращает количество удаленных сессий
+func (r *Repo) SessionDeleteExpired(ctx context.Context, expiredBefore, idleBefore, authenticatedBefore time.Time, limit int) (int, error) {
+	expired := sq.Or{sq.Lt{"refresh_expires_at": expiredBefore}}
+	if !idleBefore.IsZero() {
+		expired = append(expired, sq.Lt{"last_activity_at": idleBefore})
+	}
+	if !authenticatedBefore.IsZero() {
+		expired = append(expired, sq.Lt{"authenticated_at": authenticatedBefore})
+	}
+
+	subquery, args, err := sq.
+		Select("id").
+		From("sessions").
+		Where(expired).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	query, args, err := sq.
+		Delete("sessions").
+		Where("id IN ("+subquery+")", args...).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+// SessionMarkRotated помечает сессию как обмененную на новую.
+// Возвращает false, если сессия уже была обменена ранее
+func (r *Repo) SessionMarkRotated(ctx context.Context, id uuid.UUID, rotatedAt time.Time) (bool, error) {
+	query, args, err := sq.
+		Update("sessions").
+		Set("rotated_at", rotatedAt).
+		Where(sq.Eq{"id": id, "rotated_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark session rotated: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// SessionDeleteFamily удаляет все сессии семейства
+func (r *Repo) SessionDeleteFamily(ctx context.Context, familyID uuid.UUID) error {
+	query, args, err := sq.
+		Delete("sessions").
+		Where(sq.Eq{"family_id": familyID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete session family: %w", err)
+	}
+
+	return nil
+}
+
+// SessionUpdateTokens обновляет токены сессии
+func (r *Repo) SessionUpdateTokens(ctx context.Context, session *model.Session) error {
+	session.TokenHash = hashToken(session.Token)
+	session.RefreshTokenHash = hashToken(session.RefreshToken)
+
+	query, args, err := sq.
+		Update("sessions").
+		Set("token_hash", session.TokenHash).
+		Set("refresh_token_hash", session.RefreshTokenHash).
+		Set("expires_at", session.ExpiresAt).
+		Set("refresh_expires_at", session.RefreshExpiresAt).
+		Set("last_activity_at", session.LastActivityAt).
+		Where(sq.Eq{"id": session.ID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return domainerr.NotFound("session", session.ID.String())
+	}
+
+	return nil
+}
+
+// ===== Методы для работы с MFA =====
+
+// MFAChallengeCreate сохраняет запрос второго фактора, сохраняя хеш его токена
+func (r *Repo) MFAChallengeCreate(ctx context.Context, challenge *model.MFAChallenge) error {
+	challenge.TokenHash = hashToken(challenge.Token)
+
+	query, args, err := sq.
+		Insert("mfa_challenges").
+		Columns("id", "staff_id", "token_hash", "attempts", "expires_at", "created_at").
+		Values(challenge.ID, challenge.StaffID, challenge.TokenHash, challenge.Attempts,
+			challenge.ExpiresAt, challenge.CreatedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+// MFAChallengeGetByToken получает запрос второго фактора по токену
+func (r *Repo) MFAChallengeGetByToken(ctx context.Context, token string) (*model.MFAChallenge, error) {
+	query, args, err := sq.
+		Select("id", "staff_id", "token_hash", "attempts", "expires_at", "created_at").
+		From("mfa_challenges").
+		Where(sq.Eq{"token_hash": hashToken(token)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	challenge := &model.MFAChallenge{}
+	err = r.conn().GetContext(ctx, challenge, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerr.NotFound("mfa_challenge", "")
+		}
+		return nil, fmt.Errorf("failed to get mfa challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// MFAChallengeIncrementAttempts увеличивает счетчик неудачных попыток и возвращает его значение
+func (r *Repo) MFAChallengeIncrementAttempts(ctx context.Context, id uuid.UUID) (int, error) {
+	query := `UPDATE mfa_challenges SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`
+
+	var attempts int
+	err := r.conn().GetContext(ctx, &attempts, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, domainerr.NotFound("mfa_challenge", id.String())
+		}
+		return 0, fmt.Errorf("failed to increment mfa attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// MFAChallengeDelete удаляет запрос второго фактора
+func (r *Repo) MFAChallengeDelete(ctx context.Context, id uuid.UUID) error {
+	query, args, err := sq.
+		Delete("mfa_challenges").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete mfa challenge: %w", err)
+	}
+
+	return nil
+}
+
+// RecoveryCodesReplace заменяет резервные коды сотрудника, сохраняя их хеши
+func (r *Repo) RecoveryCodesReplace(ctx context.Context, staffID uuid.UUID, codes []string) error {
+	query, args, err := sq.
+		Delete("recovery_codes").
+		Where(sq.Eq{"staff_id": staffID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if len(codes) == 0 {
+		return nil
+	}
+
+	insert := sq.
+		Insert("recovery_codes").
+		Columns("id", "staff_id", "code_hash").
+		PlaceholderFormat(sq.Dollar)
+	for _, code := range codes {
+		insert = insert.Values(uuid.New(), staffID, hashToken(code))
+	}
+
+	query, args, err = insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// RecoveryCodeUse помечает резервный код использованным.
+// Возвращает false, если такого неиспользованного кода нет
+func (r *Repo) RecoveryCodeUse(ctx context.Context, staffID uuid.UUID, code string) (bool, error) {
+	query, args, err := sq.
+		Update("recovery_codes").
+		Set("used_at", time.Now()).
+		Where(sq.Eq{"staff_id": staffID, "code_hash": hashToken(code), "used_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// ===== Методы для работы с SecurityEvent =====
+
+// SecurityEventCreate сохраняет событие безопасности
+func (r *Repo) SecurityEventCreate(ctx context.Context, event *model.SecurityEvent) error {
+	details, err := json.Marshal(event.Details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event details: %w", err)
+	}
+
+	query, args, err := sq.
+		Insert("security_events").
+		Columns("id", "staff_id", "event_type", "details", "created_at").
+		Values(event.ID, event.StaffID, event.Type, details, event.CreatedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create security event: %w", err)
+	}
+
+	return nil
+}
+
+// ===== Методы для работы с AuditEvent =====
+
+// AuditEventCreate добавляет запись в журнал аудита
+func (r *Repo) AuditEventCreate(ctx context.Context, event *model.AuditEvent) error {
+	if event.Changes == nil {
+		event.Changes = map[string]model.AuditChange{}
+	}
+
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit changes: %w", err)
+	}
+
+	query, args, err := sq.
+		Insert("audit_events").
+		Columns("actor_id", "action", "target_type", "target_id", "changes", "ip", "created_at").
+		Values(event.ActorID, event.Action, event.TargetType, event.TargetID, changes, event.IP, event.CreatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.conn().GetContext(ctx, &event.ID, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create audit event: %w", err)
+	}
+
+	return nil
+}
+
+// AuditEventList получает записи журнала аудита от новых к старым
+func (r *Repo) AuditEventList(ctx context.Context, filter *model.AuditEventFilter) ([]*model.AuditEvent, error) {
+	builder := sq.
+		Select("id", "actor_id", "action", "target_type", "target_id", "changes", "ip", "created_at").
+		From("audit_events").
+		OrderBy("id DESC").
+		Limit(uint64(filter.Limit)).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.ActorID != nil {
+		builder = builder.Where(sq.Eq{"actor_id": *filter.ActorID})
+	}
+	if filter.TargetID != "" {
+		builder = builder.Where(sq.Eq{"target_id": filter.TargetID})
+	}
+	if filter.Action != "" {
+		builder = builder.Where(sq.Eq{"action": filter.Action})
+	}
+	if !filter.From.IsZero() {
+		builder = builder.Where(sq.GtOrEq{"created_at": filter.From})
+	}
+	if !filter.To.IsZero() {
+		builder = builder.Where(sq.Lt{"created_at": filter.To})
+	}
+	if filter.BeforeID > 0 {
+		builder = builder.Where(sq.Lt{"id": filter.BeforeID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []struct {
+		ID         int64      `db:"id"`
+		ActorID    *uuid.UUID `db:"actor_id"`
+		Action     string     `db:"action"`
+		TargetType string     `db:"target_type"`
+		TargetID   string     `db:"target_id"`
+		Changes    []byte     `db:"changes"`
+		IP         string     `db:"ip"`
+		CreatedAt  time.Time  `db:"created_at"`
+	}
+	err = r.conn().SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	events := make([]*model.AuditEvent, len(rows))
+	for i, row := range rows {
+		events[i] = &model.AuditEvent{
+			ID:         row.ID,
+			ActorID:    row.ActorID,
+			Action:     row.Action,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			IP:         row.IP,
+			CreatedAt:  row.CreatedAt,
+		}
+		if err := json.Unmarshal(row.Changes, &events[i].Changes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit changes: %w", err)
+		}
+	}
+
+	return events, nil
+}
+
+// ===== Методы для работы с SigningKey =====
+
+// SigningKeyListSince получает ключи подписи, начавшие подписывать токены после указанного момента,
+// включая заранее созданные ключи следующего слота
+func (r *Repo) SigningKeyListSince(ctx context.Context, since time.Time) ([]*model.SigningKey, error) {
+	query, args, err := sq.
+		Select("id", "algorithm", "private_key", "public_key", "not_before", "created_at").
+		From("signing_keys").
+		Where(sq.Gt{"not_before": since}).
+		OrderBy("not_before").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var keys []*model.SigningKey
+	err = r.conn().SelectContext(ctx, &keys, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signing keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// SigningKeyCreate сохраняет новый ключ подписи. Если ключ для этого алгоритма и слота
+// уже создан другой репликой, запись не добавляется
+func (r *Repo) SigningKeyCreate(ctx context.Context, key *model.SigningKey) error {
+	query, args, err := sq.
+		Insert("signing_keys").
+		Columns("id", "algorithm", "private_key", "public_key", "not_before", "created_at").
+		Values(key.ID, key.Algorithm, key.PrivateKey, key.PublicKey, key.NotBefore, key.CreatedAt).
+		Suffix("ON CONFLICT (algorithm, not_before) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create signing key: %w", err)
+	}
+
+	return nil
+}
+
+// ===== Методы для работы с LoginLockout =====
+
+// LoginLockoutGet получает счетчики неудачных попыток входа по логину и адресу
+func (r *Repo) LoginLockoutGet(ctx context.Context, login, ip string) ([]*model.LoginLockout, error) {
+	query, args, err := sq.
+		Select("scope", "subject", "failures", "locked_until", "last_failure_at").
+		From("login_lockouts").
+		Where(sq.Or{
+			sq.Eq{"scope": model.LockoutScopeLogin, "subject": login},
+			sq.Eq{"scope": model.LockoutScopeIP, "subject": ip},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var lockouts []*model.LoginLockout
+	err = r.conn().SelectContext(ctx, &lockouts, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login lockouts: %w", err)
+	}
+
+	return lockouts, nil
+}
+
+// LoginFailureRecord увеличивает счетчик неудачных попыток входа и возвращает его значение.
+// Если с последней неудачи прошло больше window, счетчик начинается заново
+func (r *Repo) LoginFailureRecord(ctx context.Context, scope, subject string, at time.Time, window time.Duration) (int, error) {
+	query := `
+		INSERT INTO login_lockouts (scope, subject, failures, last_failure_at)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (scope, subject) DO UPDATE
+		SET failures = CASE
+				WHEN login_lockouts.last_failure_at < $4 THEN 1
+				ELSE login_lockouts.failures + 1
+			END,
+			last_failure_at = EXCLUDED.last_failure_at
+		RETURNING failures
+	`
+
+	var failures int
+	err := r.conn().GetContext(ctx, &failures, query, scope, subject, at, at.Add(-window))
+	if err != nil {
+		return 0, fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	return failures, nil
+}
+
+// LoginLockoutSet блокирует вход по логину или адресу до указанного момента
+func (r *Repo) LoginLockoutSet(ctx context.Context, scope, subject string, lockedUntil time.Time) error {
+	query, args, err := sq.
+		Update("login_lockouts").
+		Set("locked_until", lockedUntil).
+		Where(sq.Eq{"scope": scope, "subject": subject}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set login lockout: %w", err)
+	}
+
+	return nil
+}
+
+// LoginLockoutList получает счетчики неудачных попыток входа с последней неудачей после since
+func (r *Repo) LoginLockoutList(ctx context.Context, since time.Time) ([]*model.LoginLockout, error) {
+	query, args, err := sq.
+		Select("scope", "subject", "failures", "locked_until", "last_failure_at").
+		From("login_lockouts").
+		Where(sq.Or{
+			sq.Gt{"last_failure_at": since},
+			sq.Gt{"locked_until": time.Now()},
+		}).
+		OrderBy("last_failure_at DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var lockouts []*model.LoginLockout
+	err = r.conn().SelectContext(ctx, &lockouts, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login lockouts: %w", err)
+	}
+
+	return lockouts, nil
+}
+
+// LoginLockoutClear сбрасывает счетчик неудачных попыток входа
+func (r *Repo) LoginLockoutClear(ctx context.Context, scope, subject string) error {
+	query, args, err := sq.
+		Delete("login_lockouts").
+		Where(sq.Eq{"scope": scope, "subject": subject}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to clear login lockout: %w", err)
+	}
+
+	return nil
+}
+
+// LoginLockoutDeleteExpired удаляет не более limit счетчиков, последняя неудача в которых была
+// до lastFailureBefore, а блокировка отсутствует или закончилась до lockedBefore.
+// Возвращает количество удаленных счетчиков
+func (r *Repo) LoginLockoutDeleteExpired(ctx context.Context, lastFailureBefore, lockedBefore time.Time, limit int) (int, error) {
+	subquery, args, err := sq.
+		Select("scope", "subject").
+		From("login_lockouts").
+		Where(sq.And{
+			sq.Lt{"last_failure_at": lastFailureBefore},
+			sq.Or{
+				sq.Eq{"locked_until": nil},
+				sq.Lt{"locked_until": lockedBefore},
+			},
+		}).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	query, args, err := sq.
+		Delete("login_lockouts").
+		Where("(scope, subject) IN ("+subquery+")", args...).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired login lockouts: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+// ===== Методы для работы с Role =====
+
+// RoleGetByID получает роль по ID
+func (r *Repo) RoleGetByID(ctx context.Context, id int) (*model.Role, error) {
+	query, args, err := sq.
+		Select("id", "name").
+		From("roles").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	role := &model.Role{}
+	err = r.conn().GetContext(ctx, role, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerr.NotFound("role", strconv.Itoa(id))
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return role, nil
+}
+
+// RoleList получает список всех ролей
+func (r *Repo) RoleList(ctx context.Context) ([]*model.Role, error) {
+	query, args, err := sq.
+		Select("id", "name").
+		From("roles").
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var roles []*model.Role
+	err = r.conn().SelectContext(ctx, &roles, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// RoleCreate создает новую роль и заполняет ее ID
+func (r *Repo) RoleCreate(ctx context.Context, role *model.Role) error {
+	query, args, err := sq.
+		Insert("roles").
+		Columns("name").
+		Values(role.Name).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = r.conn().GetContext(ctx, &role.ID, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to create role: %w", translateError(err, "role", "roles"))
+	}
+
+	return nil
+}
+
+// RoleUpdate обновляет роль
+func (r *Repo) RoleUpdate(ctx context.Context, role *model.Role) error {
+	query, args, err := sq.
+		Update("roles").
+		Set("name", role.Name).
+		Where(sq.Eq{"id": role.ID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update role: %w", translateError(err, "role", "roles"))
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return domainerr.NotFound("role", strconv.Itoa(role.ID))
+	}
+
+	return nil
+}
+
+// RoleDelete удаляет роль
+func (r *Repo) RoleDelete(ctx context.Context, id int) error {
+	query, args, err := sq.
+		Delete("roles").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", translateError(err, "role", "roles"))
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return domainerr.NotFound("role", strconv.Itoa(id))
+	}
+
+	return nil
+}
+
+// RoleIsAssigned проверяет, назначена ли роль хотя бы одному сотруднику
+func (r *Repo) RoleIsAssigned(ctx context.Context, id int) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM staff WHERE role_id = $1)`
+
+	var assigned bool
+	err := r.conn().GetContext(ctx, &assigned, query, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to check role usage: %w", err)
+	}
+
+	return assigned, nil
+}
+
+// ===== Методы для работы с AccessPolicy =====
+
+// accessPolicyRow представляет строку таблицы access_policies
+type accessPolicyRow struct {
+	Method      string         `db:"method"`
+	Roles       pq.Int64Array  `db:"roles"`
+	Permissions pq.StringArray `db:"permissions"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+// AccessPolicyList получает все политики доступа к методам
+func (r *Repo) AccessPolicyList(ctx context.Context) ([]*model.AccessPolicy, error) {
+	query, args, err := sq.
+		Select("method", "roles", "permissions", "updated_at").
+		From("access_policies").
+		OrderBy("method").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []accessPolicyRow
+	err = r.conn().SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access policies: %w", err)
+	}
+
+	policies := make([]*model.AccessPolicy, len(rows))
+	for i, row := range rows {
+		roles := make([]int, len(row.Roles))
+		for j, role := range row.Roles {
+			roles[j] = int(role)
+		}
+
+		policies[i] = &model.AccessPolicy{
+			Method:      row.Method,
+			Roles:       roles,
+			Permissions: row.Permissions,
+			UpdatedAt:   row.UpdatedAt,
+		}
+	}
+
+	return policies, nil
+}
+
+// AccessPolicyUpsert создает или обновляет политику доступа к методу
+func (r *Repo) AccessPolicyUpsert(ctx context.Context, policy *model.AccessPolicy) error {
+	roles := make(pq.Int64Array, len(policy.Roles))
+	for i, role := range policy.Roles {
+		roles[i] = int64(role)
+	}
+
+	permissions := pq.StringArray(policy.Permissions)
+	if permissions == nil {
+		permissions = pq.StringArray{}
+	}
+
+	query, args, err := sq.
+		Insert("access_policies").
+		Columns("method", "roles", "permissions", "updated_at").
+		Values(policy.Method, roles, permissions, policy.UpdatedAt).
+		Suffix("ON CONFLICT (method) DO UPDATE SET roles = EXCLUDED.roles, permissions = EXCLUDED.permissions, updated_at = EXCLUDED.updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to upsert access policy: %w", err)
+	}
+
+	return nil
+}
+
+// AccessPolicyDelete удаляет политику доступа к методу
+func (r *Repo) AccessPolicyDelete(ctx context.Context, method string) error {
+	query, args, err := sq.
+		Delete("access_policies").
+		Where(sq.Eq{"method": method}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete access policy: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return domainerr.NotFound("access_policy", method)
+	}
+
+	return nil
+}
+
+// GetStaffAccessByToken получает роль и разрешения сотрудника по токену сессии
+func (r *Repo) GetStaffAccessByToken(ctx context.Context, token string) (*model.StaffAccess, error) {
+	query := `
+		SELECT s.id AS staff_id, s.login, sess.id AS session_id, sess.family_id, s.role_id, s.permissions,
+		       sess.password_change_required, sess.last_activity_at, sess.authenticated_at
+		FROM staff s
+		JOIN sessions sess ON sess.staff_id = s.id
+		WHERE sess.token_hash = $1 AND sess.expires_at > NOW() AND sess.rotated_at IS NULL
+		  AND s.is_active AND s.deleted_at IS NULL
+	`
+
+	access := &model.StaffAccess{}
+	err := r.conn().GetContext(ctx, access, query, hashToken(token))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, ErrNotFound
+			return nil, domainerr.NotFound("session", "")
+		}
+		return nil, fmt.Errorf("failed to get staff access: %w", err)
+	}
+
+	return access, nil
+}
+
+// translateError преобразует нарушения ограничений Postgres при изменении таблицы table
+// в ошибки предметной области для объекта resource
+func translateError(err error, resource, table string) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case "23505": // unique_violation
+		return domainerr.AlreadyExists(resource, constraintField(pqErr), err)
+	case "23503": // foreign_key_violation
+		reason := "referenced object does not exist"
+		if pqErr.Table != table {
+			// Удаляемый объект используется в другой таблице
+			reason = fmt.Sprintf("%s is referenced by %s", resource, pqErr.Table)
+		}
+		return domainerr.FailedPrecondition(resource, constraintField(pqErr), reason, err)
+	}
+
+	return err
+}
+
+// constraintField извлекает имя поля из имени ограничения вида <таблица>_<поле>_key
+func constraintField(pqErr *pq.Error) string {
+	field := strings.TrimPrefix(pqErr.Constraint, pqErr.Table+"_")
+	for _, suffix := range []string{"_key", "_fkey", "_pkey"} {
+		if strings.HasSuffix(field, suffix) {
+			return strings.TrimSuffix(field, suffix)
 		}
-		return 0, fmt.Errorf("failed to get staff role: %w", err)
 	}
+	return field
+}
 
-	return roleID, nil
+// hashToken вычисляет SHA-256 хеш токена, под которым он хранится в базе
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
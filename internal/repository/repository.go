@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// DbRepo определяет все методы для работы с базой данных
+type DbRepo interface {
+	// WithTx выполняет fn в транзакции: все изменения, сделанные через переданный в fn репозиторий,
+	// применяются вместе или откатываются при ошибке
+	WithTx(ctx context.Context, fn func(repo DbRepo) error) error
+
+	// Методы для работы со Staff
+	StaffGetByID(ctx context.Context, id uuid.UUID) (*model.Staff, error)
+	StaffGetByLogin(ctx context.Context, login string) (*model.Staff, error)
+	StaffCreate(ctx context.Context, staff *model.Staff) error
+	StaffUpdate(ctx context.Context, staff *model.Staff) error
+	StaffDelete(ctx context.Context, id uuid.UUID) error
+	StaffList(ctx context.Context, filter *model.StaffFilter) ([]*model.Staff, int, error)
+	StaffLockActiveByRole(ctx context.Context, roleID int) ([]uuid.UUID, error)
+	StaffUpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) error
+	StaffSetTOTP(ctx context.Context, id uuid.UUID, secret string, enabled bool) error
+	StaffAdvanceTOTPStep(ctx context.Context, id uuid.UUID, step int64) (bool, error)
+	StaffReplaceTOTPSecret(ctx context.Context, id uuid.UUID, oldSecret, newSecret string) error
+
+	// Методы для работы с PasswordHistory
+	PasswordHistoryList(ctx context.Context, staffID uuid.UUID, limit int) ([]string, error)
+	PasswordHistoryAdd(ctx context.Context, staffID uuid.UUID, passwordHash string, keep int) error
+
+	// Методы для работы с PasswordResetToken
+	PasswordResetTokenCreate(ctx context.Context, resetToken *model.PasswordResetToken) error
+	PasswordResetTokenGetByToken(ctx context.Context, token string) (*model.PasswordResetToken, error)
+	PasswordResetTokenDelete(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// Методы для работы с Session
+	SessionCreate(ctx context.Context, session *model.Session) error
+	SessionGetByRefreshToken(ctx context.Context, refreshToken string) (*model.Session, error)
+	SessionDeleteByID(ctx context.Context, id uuid.UUID) error
+	SessionDeleteAllForStaff(ctx context.Context, staffID uuid.UUID) error
+	SessionLockStaff(ctx context.Context, staffID uuid.UUID) error
+	SessionListForStaff(ctx context.Context, staffID uuid.UUID) ([]*model.Session, error)
+	SessionRevokeForStaff(ctx context.Context, staffID, id uuid.UUID) (bool, error)
+	SessionDeleteOthersForStaff(ctx context.Context, staffID, keepFamilyID uuid.UUID) (int, error)
+	SessionTouchBatch(ctx context.Context, touches map[uuid.UUID]time.Time) error
+	SessionUpdateTokens(ctx context.Context, session *model.Session) error
+	SessionMarkRotated(ctx context.Context, id uuid.UUID, rotatedAt time.Time) (bool, error)
+	SessionDeleteFamily(ctx context.Context, familyID uuid.UUID) error
+	GetStaffAccessByToken(ctx context.Context, token string) (*model.StaffAccess, error)
+
+	// Методы для работы с MFA
+	MFAChallengeCreate(ctx context.Context, challenge *model.MFAChallenge) error
+	MFAChallengeGetByToken(ctx context.Context, token string) (*model.MFAChallenge, error)
+	MFAChallengeIncrementAttempts(ctx context.Context, id uuid.UUID) (int, error)
+	MFAChallengeDelete(ctx context.Context, id uuid.UUID) error
+	RecoveryCodesReplace(ctx context.Context, staffID uuid.UUID, codes []string) error
+	RecoveryCodeUse(ctx context.Context, staffID uuid.UUID, code string) (bool, error)
+
+	// Методы для работы с SecurityEvent
+	SecurityEventCreate(ctx context.Context, event *model.SecurityEvent) error
+
+	// Методы для работы с AuditEvent
+	AuditEventCreate(ctx context.Context, event *model.AuditEvent) error
+	AuditEventList(ctx context.Context, filter *model.AuditEventFilter) ([]*model.AuditEvent, error)
+
+	// Методы для работы с LoginLockout
+	LoginLockoutGet(ctx context.Context, login, ip string) ([]*model.LoginLockout, error)
+	LoginFailureRecord(ctx context.Context, scope, subject string, at time.Time, window time.Duration) (int, error)
+	LoginLockoutSet(ctx context.Context, scope, subject string, lockedUntil time.Time) error
+	LoginLockoutList(ctx context.Context, since time.Time) ([]*model.LoginLockout, error)
+	LoginLockoutClear(ctx context.Context, scope, subject string) error
+
+	// Методы для работы с Role
+	RoleGetByID(ctx context.Context, id int) (*model.Role, error)
+	RoleList(ctx context.Context) ([]*model.Role, error)
+	RoleCreate(ctx context.Context, role *model.Role) error
+	RoleUpdate(ctx context.Context, role *model.Role) error
+	RoleDelete(ctx context.Context, id int) error
+	RoleIsAssigned(ctx context.Context, id int) (bool, error)
+
+	// Методы для работы с AccessPolicy
+	AccessPolicyList(ctx context.Context) ([]*model.AccessPolicy, error)
+	AccessPolicyUpsert(ctx context.Context, policy *model.AccessPolicy) error
+	AccessPolicyDelete(ctx context.Context, method string) error
+}
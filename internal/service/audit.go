@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// ===== Реализация методов журнала аудита =====
+
+// ListAuditEvents получение журнала аудита с фильтрацией и постраничной выдачей по курсору
+func (s *StaffService) ListAuditEvents(ctx context.Context, req *staff.ListAuditEventsIn) (*staff.ListAuditEventsOut, error) {
+	filter := &model.AuditEventFilter{
+		Limit: int(req.PageSize),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = model.DefaultAuditPageSize
+	}
+	if filter.Limit > model.MaxAuditPageSize {
+		filter.Limit = model.MaxAuditPageSize
+	}
+
+	if req.ActorId != nil {
+		actorID, err := uuid.Parse(*req.ActorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid actor_id format")
+		}
+		filter.ActorID = &actorID
+	}
+	if req.TargetId != nil {
+		filter.TargetID = *req.TargetId
+	}
+	if req.Action != nil {
+		filter.Action = *req.Action
+	}
+	if req.From != nil {
+		filter.From = time.Unix(*req.From, 0)
+	}
+	if req.To != nil {
+		filter.To = time.Unix(*req.To, 0)
+	}
+	if req.Cursor != "" {
+		beforeID, err := strconv.ParseInt(req.Cursor, 10, 64)
+		if err != nil || beforeID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		filter.BeforeID = beforeID
+	}
+
+	events, err := s.repo.AuditEventList(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err, "failed to list audit events")
+	}
+
+	protoEvents := make([]*staff.AuditEvent, len(events))
+	for i, event := range events {
+		protoEvents[i] = convertAuditEventToProto(event)
+	}
+
+	var nextCursor string
+	if len(events) == filter.Limit {
+		nextCursor = strconv.FormatInt(events[len(events)-1].ID, 10)
+	}
+
+	return &staff.ListAuditEventsOut{
+		Events:     protoEvents,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// ===== Вспомогательные методы журнала аудита =====
+
+// audit записывает действие в журнал аудита через репозиторий транзакции изменения,
+// чтобы запись сохранялась только вместе с ним
+func (s *StaffService) audit(ctx context.Context, repo DbRepo, actorID *uuid.UUID, action, targetType, targetID string, changes map[string]model.AuditChange) error {
+	event := &model.AuditEvent{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Changes:    changes,
+		IP:         peerIP(ctx),
+		CreatedAt:  time.Now(),
+	}
+
+	if err := repo.AuditEventCreate(ctx, event); err != nil {
+		return toStatus(err, "failed to create audit event")
+	}
+
+	return nil
+}
+
+// staffChanges вычисляет изменения полей сотрудника, попадающие в журнал аудита.
+// before равен nil при создании, after - при удалении
+func staffChanges(before, after *model.Staff) map[string]model.AuditChange {
+	fields := func(staffModel *model.Staff) map[string]interface{} {
+		if staffModel == nil {
+			return map[string]interface{}{}
+		}
+		return map[string]interface{}{
+			"login":                staffModel.Login,
+			"role_id":              staffModel.RoleID,
+			"permissions":          staffModel.Permissions.Access,
+			"must_change_password": staffModel.MustChangePassword,
+			"is_active":            staffModel.IsActive,
+			"deleted_at":           staffModel.DeletedAt,
+		}
+	}
+
+	return diffFields(fields(before), fields(after))
+}
+
+// diffFields возвращает поля, значения которых различаются
+func diffFields(before, after map[string]interface{}) map[string]model.AuditChange {
+	changes := make(map[string]model.AuditChange)
+	for field, value := range after {
+		if old, ok := before[field]; !ok || !reflect.DeepEqual(old, value) {
+			changes[field] = model.AuditChange{Before: before[field], After: value}
+		}
+	}
+	for field, old := range before {
+		if _, ok := after[field]; !ok {
+			changes[field] = model.AuditChange{Before: old}
+		}
+	}
+	return changes
+}
+
+// runInTx выполняет fn в транзакции и преобразует ошибку в ошибку gRPC
+func (s *StaffService) runInTx(ctx context.Context, message string, fn func(repo DbRepo) error) error {
+	return toStatus(s.repo.WithTx(ctx, fn), message)
+}
+
+// convertAuditEventToProto преобразует модель AuditEvent в proto-сообщение
+func convertAuditEventToProto(event *model.AuditEvent) *staff.AuditEvent {
+	fields := make([]string, 0, len(event.Changes))
+	for field := range event.Changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	changes := make([]*staff.AuditChange, len(fields))
+	for i, field := range fields {
+		change := event.Changes[field]
+		changes[i] = &staff.AuditChange{
+			Field:  field,
+			Before: auditValueToJSON(change.Before),
+			After:  auditValueToJSON(change.After),
+		}
+	}
+
+	protoEvent := &staff.AuditEvent{
+		Id:         event.ID,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		Changes:    changes,
+		Ip:         event.IP,
+		CreatedAt:  event.CreatedAt.Unix(),
+	}
+	if event.ActorID != nil {
+		protoEvent.ActorId = event.ActorID.String()
+	}
+
+	return protoEvent
+}
+
+// auditValueToJSON кодирует значение поля в JSON, nil - пустая строка
+func auditValueToJSON(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
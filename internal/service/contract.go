@@ -7,30 +7,26 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/repository"
 )
 
 // DbRepo определяет все методы для работы с базой данных
-type DbRepo interface {
-	// Методы для работы со Staff
-	StaffGetByID(ctx context.Context, id uuid.UUID) (*model.Staff, error)
-	StaffGetByLogin(ctx context.Context, login string) (*model.Staff, error)
-	StaffCreate(ctx context.Context, staff *model.Staff) error
-	StaffUpdate(ctx context.Context, staff *model.Staff) error
-	StaffDelete(ctx context.Context, id uuid.UUID) error
-	StaffList(ctx context.Context, filter *model.StaffFilter) ([]*model.Staff, int, error)
+type DbRepo = repository.DbRepo
 
-	// Методы для работы с Session
-	SessionCreate(ctx context.Context, session *model.Session) error
-	SessionGetByToken(ctx context.Context, token string) (*model.Session, error)
-	SessionGetByRefreshToken(ctx context.Context, refreshToken string) (*model.Session, error)
-	SessionDelete(ctx context.Context, token string) error
-	SessionDeleteAllForStaff(ctx context.Context, staffID uuid.UUID) error
-	SessionUpdateTokens(ctx context.Context, session *model.Session) error
-	GetStaffRoleByToken(ctx context.Context, token string) (int, error)
+// PolicyReloader определяет кеш политик доступа, который перечитывается после их изменения
+type PolicyReloader interface {
+	Reload(ctx context.Context) error
+}
+
+// ActivitySource определяет источник отметок активности сессий, еще не записанных в базу
+type ActivitySource interface {
+	LastActivity(sessionID uuid.UUID) (time.Time, bool)
+}
 
-	// Методы для работы с Role
-	RoleGetByID(ctx context.Context, id int) (*model.Role, error)
-	RoleList(ctx context.Context) ([]*model.Role, error)
+// TokenSigner определяет выпуск подписанных JWT access токенов
+type TokenSigner interface {
+	Sign(claims *model.AccessClaims) (string, error)
+	PublicKeys() []model.JSONWebKey
 }
 
 // Staff представляет информацию о сотруднике
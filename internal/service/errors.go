@@ -0,0 +1,80 @@
+package service
+
+import (
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/s21platform/staff-service/internal/domainerr"
+)
+
+// toStatus преобразует ошибку в ошибку gRPC. Ошибки gRPC возвращаются без изменений,
+// ошибки предметной области - с соответствующим кодом и подробностями errdetails,
+// остальные записываются в лог и возвращаются как Internal с сообщением message
+func toStatus(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var domainErr *domainerr.Error
+	if !errors.As(err, &domainErr) {
+		log.Printf("%s: %v", message, err)
+		return status.Error(codes.Internal, message)
+	}
+
+	var st *status.Status
+	switch {
+	case errors.Is(domainErr.Kind, domainerr.ErrNotFound):
+		st = withDetails(status.New(codes.NotFound, domainErr.Resource+" not found"), &errdetails.ResourceInfo{
+			ResourceType: domainErr.Resource,
+			ResourceName: domainErr.ID,
+			Description:  domainErr.Error(),
+		})
+	case errors.Is(domainErr.Kind, domainerr.ErrAlreadyExists):
+		st = withDetails(status.New(codes.AlreadyExists, domainErr.Resource+" already exists"), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       domainErr.Field,
+				Description: domainErr.Field + " is already taken",
+			}},
+		})
+	case errors.Is(domainErr.Kind, domainerr.ErrFailedPrecondition):
+		description := domainErr.Reason
+		if description == "" {
+			description = domainErr.Error()
+		}
+		st = withDetails(status.New(codes.FailedPrecondition, description), &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        domainErr.Resource,
+				Subject:     domainErr.Field,
+				Description: domainErr.Reason,
+			}},
+		})
+	case errors.Is(domainErr.Kind, domainerr.ErrConflict):
+		st = withDetails(status.New(codes.Aborted, domainErr.Resource+" was modified concurrently"), &errdetails.ResourceInfo{
+			ResourceType: domainErr.Resource,
+			ResourceName: domainErr.ID,
+			Description:  "reload the resource and retry with its current version",
+		})
+	default:
+		log.Printf("%s: %v", message, err)
+		return status.Error(codes.Internal, message)
+	}
+
+	return st.Err()
+}
+
+// withDetails добавляет подробности к статусу, при ошибке возвращает статус без них
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
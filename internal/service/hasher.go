@@ -0,0 +1,202 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Алгоритмы хеширования паролей
+const (
+	HashAlgorithmBcrypt   = "bcrypt"
+	HashAlgorithmArgon2id = "argon2id"
+)
+
+// ErrUnknownHashFormat возвращается для хеша неизвестного формата
+var ErrUnknownHashFormat = errors.New("unknown password hash format")
+
+// PasswordHasher вычисляет и проверяет хеши паролей.
+// Хеши самоописываемые: по строке хеша определяются алгоритм и его параметры
+type PasswordHasher interface {
+	// Hash вычисляет хеш пароля текущим алгоритмом
+	Hash(password string) (string, error)
+	// Verify проверяет пароль по хешу любого поддерживаемого алгоритма
+	Verify(hash, password string) (bool, error)
+	// NeedsRehash сообщает, что хеш вычислен более слабым алгоритмом или с меньшими параметрами
+	NeedsRehash(hash string) bool
+}
+
+// Argon2Params параметры Argon2id
+type Argon2Params struct {
+	Memory      uint32 // объем памяти в КиБ
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params параметры Argon2id по умолчанию (RFC 9106, второй рекомендуемый вариант)
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 4,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Hasher реализует PasswordHasher для bcrypt и Argon2id
+type Hasher struct {
+	Algorithm  string
+	BcryptCost int
+	Argon2     Argon2Params
+	// MaxConcurrent ограничивает число одновременных вычислений хеша, 0 - без ограничения.
+	// Каждое вычисление Argon2id занимает Argon2.Memory, поэтому без ограничения поток
+	// неудачных входов может исчерпать память сервиса
+	MaxConcurrent int
+
+	slotsOnce sync.Once
+	slots     chan struct{}
+}
+
+// acquire занимает слот вычисления хеша и возвращает функцию его освобождения
+func (h *Hasher) acquire() func() {
+	h.slotsOnce.Do(func() {
+		if h.MaxConcurrent > 0 {
+			h.slots = make(chan struct{}, h.MaxConcurrent)
+		}
+	})
+	if h.slots == nil {
+		return func() {}
+	}
+
+	h.slots <- struct{}{}
+	return func() { <-h.slots }
+}
+
+// WithPasswordHasher устанавливает алгоритм хеширования паролей
+func WithPasswordHasher(hasher PasswordHasher) ServiceOption {
+	return func(s *StaffService) {
+		s.hasher = hasher
+	}
+}
+
+// Hash вычисляет хеш пароля алгоритмом Algorithm
+func (h *Hasher) Hash(password string) (string, error) {
+	release := h.acquire()
+	defer release()
+
+	switch h.Algorithm {
+	case HashAlgorithmArgon2id:
+		salt := make([]byte, h.Argon2.SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+
+		key := argon2.IDKey([]byte(password), salt, h.Argon2.Iterations, h.Argon2.Memory, h.Argon2.Parallelism, h.Argon2.KeyLength)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, h.Argon2.Memory, h.Argon2.Iterations, h.Argon2.Parallelism,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	case HashAlgorithmBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.BcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	default:
+		return "", fmt.Errorf("unsupported hash algorithm: %s", h.Algorithm)
+	}
+}
+
+// Verify проверяет пароль по хешу bcrypt или Argon2id
+func (h *Hasher) Verify(hash, password string) (bool, error) {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		params, salt, key, err := decodeArgon2Hash(hash)
+		if err != nil {
+			return false, err
+		}
+
+		release := h.acquire()
+		defer release()
+		computed := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		return subtle.ConstantTimeCompare(computed, key) == 1, nil
+	}
+
+	if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+		return false, ErrUnknownHashFormat
+	}
+
+	release := h.acquire()
+	defer release()
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NeedsRehash сообщает, что хеш нужно пересчитать с текущими настройками
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		if h.Algorithm != HashAlgorithmArgon2id {
+			return true
+		}
+
+		params, salt, key, err := decodeArgon2Hash(hash)
+		if err != nil {
+			return true
+		}
+		return params.Memory < h.Argon2.Memory ||
+			params.Iterations < h.Argon2.Iterations ||
+			params.Parallelism < h.Argon2.Parallelism ||
+			uint32(len(salt)) < h.Argon2.SaltLength ||
+			uint32(len(key)) < h.Argon2.KeyLength
+	}
+
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return true
+	}
+	// Argon2id считается сильнее bcrypt при любой стоимости
+	return h.Algorithm != HashAlgorithmBcrypt || cost < h.BcryptCost
+}
+
+// decodeArgon2Hash разбирает хеш вида $argon2id$v=19$m=65536,t=3,p=4$salt$key
+func decodeArgon2Hash(hash string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != HashAlgorithmArgon2id {
+		return params, nil, nil, ErrUnknownHashFormat
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnknownHashFormat
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrUnknownHashFormat
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnknownHashFormat
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, ErrUnknownHashFormat
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
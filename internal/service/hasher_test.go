@@ -0,0 +1,231 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params облегченные параметры Argon2id, чтобы тесты выполнялись быстро
+var testArgon2Params = Argon2Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestHasherRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		hasher *Hasher
+		prefix string
+	}{
+		{name: "argon2id", hasher: &Hasher{Algorithm: HashAlgorithmArgon2id, Argon2: testArgon2Params}, prefix: "$argon2id$"},
+		{name: "bcrypt", hasher: &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}, prefix: "$2a$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := tt.hasher.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(hash, tt.prefix) {
+				t.Fatalf("Hash() = %s, want prefix %s", hash, tt.prefix)
+			}
+
+			if ok, err := tt.hasher.Verify(hash, "correct horse"); err != nil || !ok {
+				t.Errorf("Verify() with the right password = %v, %v, want true", ok, err)
+			}
+			if ok, err := tt.hasher.Verify(hash, "wrong horse"); err != nil || ok {
+				t.Errorf("Verify() with a wrong password = %v, %v, want false", ok, err)
+			}
+			if tt.hasher.NeedsRehash(hash) {
+				t.Errorf("NeedsRehash() for a fresh hash = true")
+			}
+		})
+	}
+}
+
+func TestHasherUnsupportedAlgorithm(t *testing.T) {
+	if _, err := (&Hasher{Algorithm: "md5"}).Hash("password"); err == nil {
+		t.Fatal("Hash() with an unsupported algorithm succeeded")
+	}
+}
+
+func TestHasherVerifyDispatch(t *testing.T) {
+	// Хеш любого поддерживаемого алгоритма проверяется независимо от текущего
+	legacy, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+	argon2Hash, err := (&Hasher{Algorithm: HashAlgorithmArgon2id, Argon2: testArgon2Params}).Hash("password")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		hasher  *Hasher
+		hash    string
+		valid   bool
+		wantErr error
+	}{
+		{name: "legacy bcrypt with argon2id hasher", hasher: &Hasher{Algorithm: HashAlgorithmArgon2id, Argon2: testArgon2Params}, hash: string(legacy), valid: true},
+		{name: "argon2id with bcrypt hasher", hasher: &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}, hash: argon2Hash, valid: true},
+		{name: "unknown format", hasher: &Hasher{Algorithm: HashAlgorithmArgon2id}, hash: "plaintext", wantErr: ErrUnknownHashFormat},
+		{name: "empty hash", hasher: &Hasher{Algorithm: HashAlgorithmArgon2id}, hash: "", wantErr: ErrUnknownHashFormat},
+		{name: "malformed argon2id", hasher: &Hasher{Algorithm: HashAlgorithmArgon2id}, hash: "$argon2id$v=19$broken", wantErr: ErrUnknownHashFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := tt.hasher.Verify(tt.hash, "password")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if ok != tt.valid {
+				t.Errorf("Verify() = %v, want %v", ok, tt.valid)
+			}
+		})
+	}
+}
+
+func TestDecodeArgon2Hash(t *testing.T) {
+	const (
+		salt = "c29tZXNhbHRzb21lc2FsdA"                      // 16 байт
+		key  = "a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U" // 32 байта
+	)
+
+	tests := []struct {
+		name   string
+		hash   string
+		params Argon2Params
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			hash:   "$argon2id$v=19$m=65536,t=3,p=4$" + salt + "$" + key,
+			params: Argon2Params{Memory: 65536, Iterations: 3, Parallelism: 4, SaltLength: 16, KeyLength: 32},
+			valid:  true,
+		},
+		{name: "wrong algorithm", hash: "$argon2i$v=19$m=65536,t=3,p=4$" + salt + "$" + key},
+		{name: "wrong version", hash: "$argon2id$v=16$m=65536,t=3,p=4$" + salt + "$" + key},
+		{name: "missing version", hash: "$argon2id$m=65536,t=3,p=4$" + salt + "$" + key},
+		{name: "malformed params", hash: "$argon2id$v=19$m=abc,t=3,p=4$" + salt + "$" + key},
+		{name: "truncated params", hash: "$argon2id$v=19$m=65536$" + salt + "$" + key},
+		{name: "malformed salt", hash: "$argon2id$v=19$m=65536,t=3,p=4$!!!$" + key},
+		{name: "malformed key", hash: "$argon2id$v=19$m=65536,t=3,p=4$" + salt + "$!!!"},
+		{name: "missing key", hash: "$argon2id$v=19$m=65536,t=3,p=4$" + salt},
+		{name: "extra segment", hash: "$argon2id$v=19$m=65536,t=3,p=4$" + salt + "$" + key + "$extra"},
+		{name: "empty", hash: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, gotSalt, gotKey, err := decodeArgon2Hash(tt.hash)
+			if !tt.valid {
+				if !errors.Is(err, ErrUnknownHashFormat) {
+					t.Fatalf("decodeArgon2Hash() error = %v, want %v", err, ErrUnknownHashFormat)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("decodeArgon2Hash() error = %v", err)
+			}
+			if params != tt.params {
+				t.Errorf("decodeArgon2Hash() params = %+v, want %+v", params, tt.params)
+			}
+			if uint32(len(gotSalt)) != tt.params.SaltLength || uint32(len(gotKey)) != tt.params.KeyLength {
+				t.Errorf("decodeArgon2Hash() salt %d bytes, key %d bytes", len(gotSalt), len(gotKey))
+			}
+		})
+	}
+}
+
+func TestHasherNeedsRehash(t *testing.T) {
+	argon2Hasher := &Hasher{Algorithm: HashAlgorithmArgon2id, Argon2: testArgon2Params}
+	argon2Hash, err := argon2Hasher.Hash("password")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+
+	stronger := func(change func(p *Argon2Params)) *Hasher {
+		params := testArgon2Params
+		change(&params)
+		return &Hasher{Algorithm: HashAlgorithmArgon2id, Argon2: params}
+	}
+
+	tests := []struct {
+		name   string
+		hasher *Hasher
+		hash   string
+		want   bool
+	}{
+		{name: "same argon2id params", hasher: argon2Hasher, hash: argon2Hash},
+		{name: "weaker argon2id params", hasher: stronger(func(p *Argon2Params) { p.Memory = 32; p.Iterations = 1 }), hash: argon2Hash},
+		{name: "more memory", hasher: stronger(func(p *Argon2Params) { p.Memory *= 2 }), hash: argon2Hash, want: true},
+		{name: "more iterations", hasher: stronger(func(p *Argon2Params) { p.Iterations++ }), hash: argon2Hash, want: true},
+		{name: "more parallelism", hasher: stronger(func(p *Argon2Params) { p.Parallelism++ }), hash: argon2Hash, want: true},
+		{name: "longer salt", hasher: stronger(func(p *Argon2Params) { p.SaltLength = 32 }), hash: argon2Hash, want: true},
+		{name: "longer key", hasher: stronger(func(p *Argon2Params) { p.KeyLength = 64 }), hash: argon2Hash, want: true},
+		{name: "argon2id with bcrypt hasher", hasher: &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}, hash: argon2Hash, want: true},
+		{name: "bcrypt with argon2id hasher", hasher: argon2Hasher, hash: string(bcryptHash), want: true},
+		{name: "bcrypt same cost", hasher: &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}, hash: string(bcryptHash)},
+		{name: "bcrypt higher cost", hasher: &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost + 1}, hash: string(bcryptHash), want: true},
+		{name: "malformed argon2id", hasher: argon2Hasher, hash: "$argon2id$v=19$broken", want: true},
+		{name: "unknown format", hasher: argon2Hasher, hash: "plaintext", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasherMaxConcurrent(t *testing.T) {
+	hasher := &Hasher{Algorithm: HashAlgorithmArgon2id, Argon2: testArgon2Params, MaxConcurrent: 2}
+
+	var (
+		inFlight atomic.Int32
+		peak     atomic.Int32
+		wg       sync.WaitGroup
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := hasher.acquire()
+			defer release()
+
+			current := inFlight.Add(1)
+			for {
+				observed := peak.Load()
+				if current <= observed || peak.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > int32(hasher.MaxConcurrent) {
+		t.Fatalf("peak concurrent hashes = %d, want at most %d", got, hasher.MaxConcurrent)
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/principal"
+)
+
+// ===== Иерархия ролей =====
+
+// checkCanManage проверяет, что сотрудник строго ниже вызывающего по иерархии ролей.
+// Владелец может управлять любым сотрудником, в том числе другими владельцами
+func checkCanManage(caller *principal.Principal, target *model.Staff) error {
+	if caller.RoleID == model.RoleOwner {
+		return nil
+	}
+	if model.RoleLevel(target.RoleID) >= model.RoleLevel(caller.RoleID) {
+		return status.Error(codes.PermissionDenied, "cannot manage staff with the same or a higher role")
+	}
+	return nil
+}
+
+// checkCanAssignRole проверяет, что вызывающий может назначить роль: только роли строго ниже собственной.
+// Владелец может назначить любую роль, иначе передать владение было бы невозможно
+func checkCanAssignRole(caller *principal.Principal, roleID int) error {
+	if caller.RoleID == model.RoleOwner {
+		return nil
+	}
+	if model.RoleLevel(roleID) >= model.RoleLevel(caller.RoleID) {
+		return status.Error(codes.PermissionDenied, "cannot assign a role at or above own")
+	}
+	return nil
+}
+
+// checkCanChangePermissions проверяет, что вызывающий может заменить разрешения сотрудника
+// before на after. Свои разрешения менять нельзя, а выдавать и отзывать не владелец может
+// только разрешения, которые есть у него самого
+func checkCanChangePermissions(caller *principal.Principal, target *model.Staff, before, after []string) error {
+	if target != nil && target.ID == caller.StaffID {
+		return status.Error(codes.PermissionDenied, "cannot change own permissions")
+	}
+	if caller.RoleID == model.RoleOwner {
+		return nil
+	}
+
+	for _, permission := range permissionsDiff(before, after) {
+		if !caller.Permissions.Has(permission) {
+			return status.Errorf(codes.PermissionDenied, "cannot grant or revoke permission %q that caller does not hold", permission)
+		}
+	}
+	return nil
+}
+
+// permissionsDiff возвращает разрешения, которые есть только в одном из наборов
+func permissionsDiff(before, after []string) []string {
+	set := make(map[string]int, len(before)+len(after))
+	for _, permission := range before {
+		set[permission] |= 1
+	}
+	for _, permission := range after {
+		set[permission] |= 2
+	}
+
+	var diff []string
+	for permission, mask := range set {
+		if mask != 3 {
+			diff = append(diff, permission)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// ensureOwnerRemains проверяет, что после изменения останется хотя бы один активный владелец.
+// Вызывается в транзакции: записи владельцев блокируются, чтобы параллельные изменения
+// не лишили систему последнего владельца
+func (s *StaffService) ensureOwnerRemains(ctx context.Context, repo DbRepo, before, after *model.Staff) error {
+	if before.RoleID != model.RoleOwner || !before.Active() {
+		return nil
+	}
+	if after != nil && after.RoleID == model.RoleOwner && after.Active() {
+		return nil
+	}
+
+	owners, err := repo.StaffLockActiveByRole(ctx, model.RoleOwner)
+	if err != nil {
+		return err
+	}
+	for _, id := range owners {
+		if id != before.ID {
+			return nil
+		}
+	}
+
+	return status.Error(codes.FailedPrecondition, "at least one active owner must remain")
+}
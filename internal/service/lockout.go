@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/principal"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// LoginThrottle настройки защиты входа от подбора пароля
+type LoginThrottle struct {
+	MaxLoginFailures int           // число неудач подряд для одного логина до блокировки
+	MaxIPFailures    int           // число неудач подряд с одного адреса до блокировки
+	FailureWindow    time.Duration // неудачи старше этого интервала не учитываются
+	BaseLockout      time.Duration // длительность первой блокировки, каждая следующая вдвое дольше
+	MaxLockout       time.Duration // максимальная длительность блокировки
+}
+
+// DefaultLoginThrottle настройки защиты входа по умолчанию
+var DefaultLoginThrottle = LoginThrottle{
+	MaxLoginFailures: 5,
+	MaxIPFailures:    20,
+	FailureWindow:    15 * time.Minute,
+	BaseLockout:      time.Minute,
+	MaxLockout:       time.Hour,
+}
+
+// WithLoginThrottle устанавливает настройки защиты входа от подбора пароля
+func WithLoginThrottle(throttle LoginThrottle) ServiceOption {
+	return func(s *StaffService) {
+		s.loginThrottle = throttle
+	}
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// compareDummyPassword выполняет сравнение с фиктивным хешем, чтобы ответ для
+// несуществующего логина занимал столько же времени, сколько для неверного пароля
+func (s *StaffService) compareDummyPassword(password string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = s.hasher.Hash("dummy-password")
+	})
+	_, _ = s.hasher.Verify(dummyHash, password)
+}
+
+// invalidCredentialsError единый ответ на неудачный вход, не раскрывающий причину
+func invalidCredentialsError() error {
+	return status.Error(codes.Unauthenticated, "invalid login or password")
+}
+
+// isLoginLocked проверяет, заблокирован ли вход по логину или адресу клиента
+func (s *StaffService) isLoginLocked(ctx context.Context, login, ip string) (bool, error) {
+	lockouts, err := s.repo.LoginLockoutGet(ctx, login, ip)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	for _, lockout := range lockouts {
+		if lockout.IsLocked(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// recordLoginFailure учитывает неудачную попытку входа и при превышении порога блокирует вход
+func (s *StaffService) recordLoginFailure(ctx context.Context, login, ip string) {
+	s.recordFailure(ctx, model.LockoutScopeLogin, login, s.loginThrottle.MaxLoginFailures)
+	if ip != "" {
+		s.recordFailure(ctx, model.LockoutScopeIP, ip, s.loginThrottle.MaxIPFailures)
+	}
+}
+
+// recordFailure увеличивает счетчик неудач и при необходимости устанавливает блокировку
+func (s *StaffService) recordFailure(ctx context.Context, scope, subject string, threshold int) {
+	now := time.Now()
+
+	failures, err := s.repo.LoginFailureRecord(ctx, scope, subject, now, s.loginThrottle.FailureWindow)
+	if err != nil {
+		log.Printf("failed to record login failure: %v", err)
+		return
+	}
+	if threshold <= 0 || failures < threshold {
+		return
+	}
+
+	lockedUntil := now.Add(s.lockoutDuration(failures - threshold))
+	if err := s.repo.LoginLockoutSet(ctx, scope, subject, lockedUntil); err != nil {
+		log.Printf("failed to set login lockout: %v", err)
+		return
+	}
+	log.Printf("login locked by %s %q until %s after %d failures", scope, subject, lockedUntil.Format(time.RFC3339), failures)
+}
+
+// lockoutDuration вычисляет длительность блокировки: BaseLockout удваивается
+// за каждую неудачу сверх порога, но не превышает MaxLockout
+func (s *StaffService) lockoutDuration(overThreshold int) time.Duration {
+	factor := math.Pow(2, float64(overThreshold))
+	duration := time.Duration(float64(s.loginThrottle.BaseLockout) * factor)
+	if duration <= 0 || duration > s.loginThrottle.MaxLockout {
+		return s.loginThrottle.MaxLockout
+	}
+	return duration
+}
+
+// peerIP возвращает адрес клиента без порта
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// ListLoginLockouts получение счетчиков неудачных попыток входа и действующих блокировок
+func (s *StaffService) ListLoginLockouts(ctx context.Context, _ *staff.ListLoginLockoutsIn) (*staff.ListLoginLockoutsOut, error) {
+	lockouts, err := s.repo.LoginLockoutList(ctx, time.Now().Add(-s.loginThrottle.FailureWindow))
+	if err != nil {
+		return nil, toStatus(err, "failed to list login lockouts")
+	}
+
+	protoLockouts := make([]*staff.LoginLockout, len(lockouts))
+	for i, lockout := range lockouts {
+		protoLockouts[i] = &staff.LoginLockout{
+			Scope:         lockout.Scope,
+			Subject:       lockout.Subject,
+			Failures:      int32(lockout.Failures),
+			LastFailureAt: lockout.LastFailureAt.Unix(),
+		}
+		if lockout.LockedUntil != nil {
+			protoLockouts[i].LockedUntil = lockout.LockedUntil.Unix()
+		}
+	}
+
+	return &staff.ListLoginLockoutsOut{
+		Lockouts: protoLockouts,
+	}, nil
+}
+
+// ClearLoginLockout снятие блокировки входа по логину или адресу
+func (s *StaffService) ClearLoginLockout(ctx context.Context, req *staff.ClearLoginLockoutIn) (*staff.ClearLoginLockoutOut, error) {
+	if req.Scope != model.LockoutScopeLogin && req.Scope != model.LockoutScopeIP {
+		return nil, status.Error(codes.InvalidArgument, "scope must be login or ip")
+	}
+	if req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "subject is required")
+	}
+
+	err := s.runInTx(ctx, "failed to clear login lockout", func(repo DbRepo) error {
+		if err := repo.LoginLockoutClear(ctx, req.Scope, req.Subject); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionLoginLockoutClear, model.AuditTargetLoginLockout,
+			req.Scope+":"+req.Subject, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.ClearLoginLockoutOut{
+		Success: true,
+	}, nil
+}
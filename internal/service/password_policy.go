@@ -0,0 +1,208 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// bcryptMaxPasswordBytes bcrypt учитывает только первые 72 байта пароля
+const bcryptMaxPasswordBytes = 72
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+// commonPasswords множество распространенных паролей в нижнем регистре
+var commonPasswords = loadCommonPasswords(commonPasswordsFile)
+
+// PasswordPolicy требования к паролям сотрудников
+type PasswordPolicy struct {
+	MinLength     int           // минимальная длина в символах
+	MaxLength     int           // максимальная длина в байтах, не больше 72 из-за ограничения bcrypt
+	RequireUpper  bool          // нужна заглавная буква
+	RequireLower  bool          // нужна строчная буква
+	RequireDigit  bool          // нужна цифра
+	RequireSymbol bool          // нужен символ, не являющийся буквой или цифрой
+	RejectLogin   bool          // пароль не должен содержать логин
+	RejectCommon  bool          // пароль не должен входить в список распространенных
+	HistorySize   int           // количество последних паролей, включая действующий, которые нельзя использовать повторно
+	MaxAge        time.Duration // срок действия пароля, 0 - бессрочно
+}
+
+// DefaultPasswordPolicy требования к паролям по умолчанию
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:    12,
+	MaxLength:    bcryptMaxPasswordBytes,
+	RequireUpper: true,
+	RequireLower: true,
+	RequireDigit: true,
+	RejectLogin:  true,
+	RejectCommon: true,
+	HistorySize:  5,
+}
+
+// WithPasswordPolicy устанавливает требования к паролям
+func WithPasswordPolicy(policy PasswordPolicy) ServiceOption {
+	return func(s *StaffService) {
+		if policy.MaxLength <= 0 || policy.MaxLength > bcryptMaxPasswordBytes {
+			policy.MaxLength = bcryptMaxPasswordBytes
+		}
+		s.passwordPolicy = policy
+	}
+}
+
+// Validate проверяет пароль и возвращает нарушения для поля field
+func (p PasswordPolicy) Validate(field, password, login string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: description,
+		})
+	}
+
+	if length := len([]rune(password)); length < p.MinLength {
+		violate(fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	if len(password) > p.MaxLength {
+		violate(fmt.Sprintf("must be at most %d bytes long", p.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		violate("must contain an uppercase letter")
+	}
+	if p.RequireLower && !hasLower {
+		violate("must contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		violate("must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violate("must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+	if p.RejectLogin && login != "" && strings.Contains(lowered, strings.ToLower(login)) {
+		violate("must not contain the login")
+	}
+	if p.RejectCommon {
+		if _, ok := commonPasswords[lowered]; ok {
+			violate("is too common")
+		}
+	}
+
+	return violations
+}
+
+// previousPasswords возвращает, сколько прежних паролей хранится в истории.
+// Действующий пароль проверяется по хешу сотрудника, поэтому в истории на один меньше HistorySize
+func (p PasswordPolicy) previousPasswords() int {
+	if p.HistorySize <= 1 {
+		return 0
+	}
+	return p.HistorySize - 1
+}
+
+// passwordChangeRequired проверяет, нужно ли сотруднику сменить пароль перед работой:
+// пароль выдан администратором или истек срок его действия
+func (s *StaffService) passwordChangeRequired(staffModel *model.Staff, now time.Time) bool {
+	if staffModel.MustChangePassword {
+		return true
+	}
+	return s.passwordPolicy.MaxAge > 0 && now.Sub(staffModel.PasswordChangedAt) > s.passwordPolicy.MaxAge
+}
+
+// validatePassword проверяет новый пароль по политике и истории паролей сотрудника.
+// currentHash действующий хеш пароля, пустой при создании сотрудника
+func (s *StaffService) validatePassword(ctx context.Context, field, password, login string, staffID uuid.UUID, currentHash string) error {
+	violations := s.passwordPolicy.Validate(field, password, login)
+	if len(violations) > 0 {
+		return passwordViolationsError(violations)
+	}
+
+	if currentHash == "" || s.passwordPolicy.HistorySize <= 0 {
+		return nil
+	}
+
+	hashes := []string{currentHash}
+	if previous := s.passwordPolicy.previousPasswords(); previous > 0 {
+		history, err := s.repo.PasswordHistoryList(ctx, staffID, previous)
+		if err != nil {
+			return toStatus(err, "failed to get password history")
+		}
+		hashes = append(hashes, history...)
+	}
+
+	for _, hash := range hashes {
+		if reused, _ := s.hasher.Verify(hash, password); reused {
+			return passwordViolationsError([]*errdetails.BadRequest_FieldViolation{{
+				Field:       field,
+				Description: fmt.Sprintf("must differ from the last %d passwords", s.passwordPolicy.HistorySize),
+			}})
+		}
+	}
+
+	return nil
+}
+
+// rememberPassword сохраняет прежний хеш пароля в истории
+func (s *StaffService) rememberPassword(ctx context.Context, repo DbRepo, staffModel *model.Staff) error {
+	previous := s.passwordPolicy.previousPasswords()
+	if previous <= 0 {
+		return nil
+	}
+
+	if err := repo.PasswordHistoryAdd(ctx, staffModel.ID, staffModel.PasswordHash, previous); err != nil {
+		return toStatus(err, "failed to save password history")
+	}
+
+	return nil
+}
+
+// passwordViolationsError формирует ошибку InvalidArgument с описанием нарушенных требований
+func passwordViolationsError(violations []*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(codes.InvalidArgument, "password does not satisfy policy").
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "password does not satisfy policy")
+	}
+	return st.Err()
+}
+
+// loadCommonPasswords разбирает список распространенных паролей
+func loadCommonPasswords(file string) map[string]struct{} {
+	passwords := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(strings.NewReader(file))
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			passwords[strings.ToLower(password)] = struct{}{}
+		}
+	}
+
+	return passwords
+}
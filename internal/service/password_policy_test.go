@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	policy := DefaultPasswordPolicy
+	policy.RequireSymbol = true
+
+	tests := []struct {
+		name       string
+		password   string
+		login      string
+		violations []string
+	}{
+		{name: "valid", password: "Tr0ub4dor&3-horse", login: "ivanov"},
+		{name: "too short", password: "Ab1!", violations: []string{"must be at least 12 characters long"}},
+		{
+			name:       "longer than bcrypt limit",
+			password:   "Aa1!" + strings.Repeat("x", bcryptMaxPasswordBytes),
+			violations: []string{"must be at most 72 bytes long"},
+		},
+		{name: "exactly bcrypt limit", password: "Aa1!" + strings.Repeat("x", bcryptMaxPasswordBytes-4)},
+		{
+			// Ограничение длины в байтах, а не в символах
+			name:       "multibyte over limit",
+			password:   "Aa1!" + strings.Repeat("ж", 35),
+			violations: []string{"must be at most 72 bytes long"},
+		},
+		{name: "no uppercase", password: "tr0ub4dor&3-horse", violations: []string{"must contain an uppercase letter"}},
+		{name: "no lowercase", password: "TR0UB4DOR&3-HORSE", violations: []string{"must contain a lowercase letter"}},
+		{name: "no digit", password: "Troubador&-horse", violations: []string{"must contain a digit"}},
+		{name: "no symbol", password: "Tr0ub4dor3horse", violations: []string{"must contain a symbol"}},
+		{name: "contains login", password: "Ivanov-Secret-42", login: "ivanov", violations: []string{"must not contain the login"}},
+		{
+			name:       "common password in any case",
+			password:   "PASSWORD",
+			violations: []string{"must be at least 12 characters long", "must contain a lowercase letter", "must contain a digit", "must contain a symbol", "is too common"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := policy.Validate("password", tt.password, tt.login)
+
+			got := make([]string, len(violations))
+			for i, violation := range violations {
+				if violation.Field != "password" {
+					t.Errorf("violation field = %s, want password", violation.Field)
+				}
+				got[i] = violation.Description
+			}
+			if strings.Join(got, "; ") != strings.Join(tt.violations, "; ") {
+				t.Errorf("Validate() = %v, want %v", got, tt.violations)
+			}
+		})
+	}
+}
+
+func TestCommonPasswordsList(t *testing.T) {
+	if len(commonPasswords) == 0 {
+		t.Fatal("common passwords list is empty")
+	}
+	for password := range commonPasswords {
+		if password != strings.ToLower(strings.TrimSpace(password)) {
+			t.Errorf("common password %q is not normalized", password)
+		}
+	}
+
+	policy := PasswordPolicy{RejectCommon: true, MaxLength: bcryptMaxPasswordBytes}
+	if violations := policy.Validate("password", "Password", ""); len(violations) != 1 {
+		t.Errorf("Validate() for a common password = %v, want one violation", violations)
+	}
+	policy.RejectCommon = false
+	if violations := policy.Validate("password", "Password", ""); len(violations) != 0 {
+		t.Errorf("Validate() with RejectCommon disabled = %v, want none", violations)
+	}
+}
+
+// passwordHistoryRepo хранит историю паролей в памяти
+type passwordHistoryRepo struct {
+	DbRepo
+
+	history []string // хеши прежних паролей, начиная с самого нового
+}
+
+func (r *passwordHistoryRepo) PasswordHistoryList(_ context.Context, _ uuid.UUID, limit int) ([]string, error) {
+	if limit < len(r.history) {
+		return r.history[:limit], nil
+	}
+	return r.history, nil
+}
+
+func (r *passwordHistoryRepo) PasswordHistoryAdd(_ context.Context, _ uuid.UUID, passwordHash string, keep int) error {
+	r.history = append([]string{passwordHash}, r.history...)
+	if len(r.history) > keep {
+		r.history = r.history[:keep]
+	}
+	return nil
+}
+
+func TestValidatePasswordReuse(t *testing.T) {
+	const historySize = 3
+
+	hasher := &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}
+	policy := PasswordPolicy{MaxLength: bcryptMaxPasswordBytes, HistorySize: historySize}
+	repo := &passwordHistoryRepo{}
+	s := New(repo, WithPasswordHasher(hasher), WithPasswordPolicy(policy))
+	staffID := uuid.New()
+
+	hash := func(password string) string {
+		t.Helper()
+		h, err := hasher.Hash(password)
+		if err != nil {
+			t.Fatalf("Hash() error = %v", err)
+		}
+		return h
+	}
+
+	// Сотрудник последовательно сменил пароли first -> second -> third -> fourth
+	passwords := []string{"first", "second", "third", "fourth"}
+	current := hash(passwords[0])
+	for _, password := range passwords[1:] {
+		staffModel := staffWithHash(staffID, current)
+		if err := s.rememberPassword(context.Background(), repo, staffModel); err != nil {
+			t.Fatalf("rememberPassword() error = %v", err)
+		}
+		current = hash(password)
+	}
+
+	if len(repo.history) != historySize-1 {
+		t.Fatalf("history keeps %d hashes, want %d", len(repo.history), historySize-1)
+	}
+
+	tests := []struct {
+		password string
+		reused   bool
+	}{
+		{password: "fourth", reused: true}, // действующий
+		{password: "third", reused: true},
+		{password: "second", reused: true},
+		{password: "first", reused: false}, // старше HistorySize паролей
+		{password: "fifth", reused: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			err := s.validatePassword(context.Background(), "new_password", tt.password, "", staffID, current)
+			if !tt.reused {
+				if err != nil {
+					t.Fatalf("validatePassword() error = %v", err)
+				}
+				return
+			}
+
+			st, _ := status.FromError(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("validatePassword() code = %v, want %v", st.Code(), codes.InvalidArgument)
+			}
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.FieldViolations) == 1 &&
+					badRequest.FieldViolations[0].Description == "must differ from the last 3 passwords" {
+					return
+				}
+			}
+			t.Errorf("validatePassword() details = %v, want a reuse violation", st.Details())
+		})
+	}
+}
+
+func TestValidatePasswordWithoutHistory(t *testing.T) {
+	hasher := &Hasher{Algorithm: HashAlgorithmBcrypt, BcryptCost: bcrypt.MinCost}
+	repo := &passwordHistoryRepo{}
+	s := New(repo, WithPasswordHasher(hasher), WithPasswordPolicy(PasswordPolicy{MaxLength: bcryptMaxPasswordBytes, HistorySize: 1}))
+
+	current, err := hasher.Hash("current")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	// При HistorySize 1 запрещен только действующий пароль, история не ведется
+	if err := s.rememberPassword(context.Background(), repo, staffWithHash(uuid.New(), current)); err != nil {
+		t.Fatalf("rememberPassword() error = %v", err)
+	}
+	if len(repo.history) != 0 {
+		t.Fatalf("history keeps %d hashes, want 0", len(repo.history))
+	}
+	if err := s.validatePassword(context.Background(), "new_password", "current", "", uuid.New(), current); err == nil {
+		t.Fatal("validatePassword() accepted the current password")
+	}
+
+	// Новому сотруднику проверять нечего
+	if err := s.validatePassword(context.Background(), "password", "current", "", uuid.New(), ""); err != nil {
+		t.Fatalf("validatePassword() for a new staff error = %v", err)
+	}
+}
+
+// staffWithHash создает сотрудника с указанным хешем пароля
+func staffWithHash(id uuid.UUID, passwordHash string) *model.Staff {
+	return &model.Staff{ID: id, PasswordHash: passwordHash}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/domainerr"
+	"github.com/s21platform/staff-service/internal/model"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// DefaultPasswordResetTTL срок действия токена сброса пароля по умолчанию
+const DefaultPasswordResetTTL = time.Hour
+
+// WithPasswordResetTTL устанавливает срок действия токена сброса пароля
+func WithPasswordResetTTL(ttl time.Duration) ServiceOption {
+	return func(s *StaffService) {
+		s.passwordResetTTL = ttl
+	}
+}
+
+// ===== Реализация методов сброса пароля =====
+
+// RequestPasswordReset выдача одноразового токена сброса пароля сотрудника
+func (s *StaffService) RequestPasswordReset(ctx context.Context, req *staff.RequestPasswordResetIn) (*staff.RequestPasswordResetOut, error) {
+	staffID, err := uuid.Parse(req.StaffId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid staff_id format")
+	}
+
+	issuer, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	staffModel, err := s.repo.StaffGetByID(ctx, staffID)
+	if err != nil {
+		return nil, toStatus(err, "failed to get staff")
+	}
+	if !staffModel.Active() {
+		return nil, status.Error(codes.FailedPrecondition, "staff account is deactivated")
+	}
+	// Токен возвращается вызывающему и позволяет войти от имени сотрудника,
+	// поэтому выдать его можно только сотруднику строго ниже по иерархии
+	if err := checkCanManage(issuer, staffModel); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resetToken := &model.PasswordResetToken{
+		ID:        uuid.New(),
+		StaffID:   staffModel.ID,
+		Token:     generateToken(),
+		CreatedBy: &issuer.StaffID,
+		ExpiresAt: now.Add(s.passwordResetTTL),
+		CreatedAt: now,
+	}
+
+	err = s.runInTx(ctx, "failed to create password reset token", func(repo DbRepo) error {
+		if err := repo.PasswordResetTokenCreate(ctx, resetToken); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &issuer.StaffID, model.AuditActionPasswordResetRequest, model.AuditTargetStaff,
+			staffModel.ID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.RequestPasswordResetOut{
+		ResetToken: resetToken.Token,
+		ExpiresAt:  resetToken.ExpiresAt.Unix(),
+	}, nil
+}
+
+// CompletePasswordReset установка нового пароля по токену сброса.
+// Токен одноразовый, все сессии сотрудника завершаются
+func (s *StaffService) CompletePasswordReset(ctx context.Context, req *staff.CompletePasswordResetIn) (*staff.CompletePasswordResetOut, error) {
+	if req.ResetToken == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "reset_token and new_password are required")
+	}
+
+	resetToken, err := s.repo.PasswordResetTokenGetByToken(ctx, req.ResetToken)
+	if domainerr.IsNotFound(err) {
+		return nil, status.Error(codes.Unauthenticated, "invalid reset token")
+	}
+	if err != nil {
+		return nil, toStatus(err, "failed to get password reset token")
+	}
+
+	if resetToken.ExpiresAt.Before(time.Now()) {
+		if _, err := s.repo.PasswordResetTokenDelete(ctx, resetToken.ID); err != nil {
+			return nil, toStatus(err, "failed to delete expired password reset token")
+		}
+		return nil, status.Error(codes.Unauthenticated, "reset token expired")
+	}
+
+	staffModel, err := s.repo.StaffGetByID(ctx, resetToken.StaffID)
+	if domainerr.IsNotFound(err) {
+		return nil, status.Error(codes.Unauthenticated, "invalid reset token")
+	}
+	if err != nil {
+		return nil, toStatus(err, "failed to get staff")
+	}
+	if !staffModel.Active() {
+		return nil, status.Error(codes.Unauthenticated, "invalid reset token")
+	}
+
+	// Проверяем пароль до использования токена, чтобы ошибка ввода не сжигала токен
+	if err := s.validatePassword(ctx, "new_password", req.NewPassword, staffModel.Login, staffModel.ID, staffModel.PasswordHash); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := s.hasher.Hash(req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	err = s.runInTx(ctx, "failed to reset password", func(repo DbRepo) error {
+		deleted, err := repo.PasswordResetTokenDelete(ctx, resetToken.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			// Токен уже использован параллельным запросом
+			return status.Error(codes.Unauthenticated, "invalid reset token")
+		}
+
+		if err := s.rememberPassword(ctx, repo, staffModel); err != nil {
+			return err
+		}
+
+		staffModel.PasswordHash = hashedPassword
+		staffModel.MustChangePassword = false
+		staffModel.PasswordChangedAt = time.Now()
+		staffModel.UpdatedAt = time.Now()
+
+		if err := repo.StaffUpdate(ctx, staffModel); err != nil {
+			return err
+		}
+		if err := repo.SessionDeleteAllForStaff(ctx, staffModel.ID); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &resetToken.StaffID, model.AuditActionPasswordReset, model.AuditTargetStaff,
+			staffModel.ID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.CompletePasswordResetOut{
+		Success: true,
+	}, nil
+}
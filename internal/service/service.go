@@ -2,22 +2,23 @@ package service
 
 import (
 	"context"
+	"crypto/cipher"
 	"errors"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/s21platform/staff-service/internal/domainerr"
 	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/principal"
 	staff "github.com/s21platform/staff-service/pkg/staff"
 )
 
 var (
-	// ErrNotFound возвращается, когда запрашиваемый объект не найден
-	ErrNotFound = errors.New("not found")
 	// ErrInvalidCredentials возвращается при неверных учетных данных
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	// ErrSessionExpired возвращается, когда срок действия сессии истек
@@ -34,19 +35,31 @@ const (
 	DefaultAccessTokenTTL = 1 * time.Hour
 	// DefaultRefreshTokenTTL срок действия refresh токена по умолчанию (7 дней)
 	DefaultRefreshTokenTTL = 7 * 24 * time.Hour
-	// DefaultBcryptCost стоимость хеширования bcrypt
-	DefaultBcryptCost = 10
+	// DefaultBcryptCost стоимость хеширования bcrypt, совпадает со значением по умолчанию в конфигурации
+	DefaultBcryptCost = 12
 )
 
 // StaffService реализует gRPC API для управления персоналом
 type StaffService struct {
 	staff.UnimplementedStaffServiceServer
-	repo DbRepo
+	repo     DbRepo
+	signer   TokenSigner
+	hasher   PasswordHasher
+	policies PolicyReloader
+	activity ActivitySource
 
 	// Настройки сервиса
-	accessTokenTTL  time.Duration
-	refreshTokenTTL time.Duration
-	bcryptCost      int
+	accessTokenTTL   time.Duration
+	refreshTokenTTL  time.Duration
+	bcryptCost       int
+	loginThrottle    LoginThrottle
+	passwordPolicy   PasswordPolicy
+	totpIssuer       string
+	totpCipher       cipher.AEAD
+	passwordResetTTL time.Duration
+	sessionTimeouts  model.SessionTimeouts
+	sessionLimit     SessionLimit
+	purgeRetention   time.Duration
 }
 
 // NewStaffService создает новый экземпляр сервиса
@@ -55,16 +68,28 @@ func New(
 	opts ...ServiceOption,
 ) *StaffService {
 	s := &StaffService{
-		repo:            repo,
-		accessTokenTTL:  DefaultAccessTokenTTL,
-		refreshTokenTTL: DefaultRefreshTokenTTL,
-		bcryptCost:      DefaultBcryptCost,
+		repo:             repo,
+		accessTokenTTL:   DefaultAccessTokenTTL,
+		refreshTokenTTL:  DefaultRefreshTokenTTL,
+		bcryptCost:       DefaultBcryptCost,
+		loginThrottle:    DefaultLoginThrottle,
+		passwordPolicy:   DefaultPasswordPolicy,
+		totpIssuer:       DefaultTOTPIssuer,
+		passwordResetTTL: DefaultPasswordResetTTL,
+		purgeRetention:   DefaultPurgeRetention,
 	}
 
 	for _, opt := range opts {
 		opt(s)
 	}
 
+	if s.hasher == nil {
+		s.hasher = &Hasher{
+			Algorithm:  HashAlgorithmBcrypt,
+			BcryptCost: s.bcryptCost,
+		}
+	}
+
 	return s
 }
 
@@ -85,13 +110,42 @@ func WithRefreshTokenTTL(ttl time.Duration) ServiceOption {
 	}
 }
 
-// WithBcryptCost устанавливает стоимость хеширования bcrypt
+// WithBcryptCost устанавливает стоимость хеширования bcrypt, если не задан WithPasswordHasher
 func WithBcryptCost(cost int) ServiceOption {
 	return func(s *StaffService) {
 		s.bcryptCost = cost
 	}
 }
 
+// WithSessionTimeouts устанавливает тайм-аут бездействия и максимальное время жизни сессии
+func WithSessionTimeouts(timeouts model.SessionTimeouts) ServiceOption {
+	return func(s *StaffService) {
+		s.sessionTimeouts = timeouts
+	}
+}
+
+// WithTokenSigner включает выдачу подписанных JWT access токенов
+func WithTokenSigner(signer TokenSigner) ServiceOption {
+	return func(s *StaffService) {
+		s.signer = signer
+	}
+}
+
+// WithPolicyReloader устанавливает кеш политик доступа, который перечитывается сразу после их изменения
+func WithPolicyReloader(policies PolicyReloader) ServiceOption {
+	return func(s *StaffService) {
+		s.policies = policies
+	}
+}
+
+// WithActivitySource устанавливает источник отметок активности, еще не записанных в базу,
+// чтобы проверка бездействия при обновлении токена учитывала их так же, как AuthInterceptor
+func WithActivitySource(activity ActivitySource) ServiceOption {
+	return func(s *StaffService) {
+		s.activity = activity
+	}
+}
+
 // ===== Реализация методов управления персоналом =====
 
 // GetStaff получает информацию о сотруднике по ID
@@ -103,10 +157,7 @@ func (s *StaffService) Get(ctx context.Context, req *staff.GetIn) (*staff.GetOut
 
 	staffModel, err := s.repo.StaffGetByID(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get staff")
-	}
-	if staffModel == nil {
-		return nil, status.Error(codes.NotFound, "staff not found")
+		return nil, toStatus(err, "failed to get staff")
 	}
 
 	return &staff.GetOut{
@@ -117,11 +168,22 @@ func (s *StaffService) Get(ctx context.Context, req *staff.GetIn) (*staff.GetOut
 // CreateStaff создает нового сотрудника
 func (s *StaffService) Create(ctx context.Context, req *staff.CreateIn) (*staff.CreateOut, error) {
 	if req.Login == "" || req.Password == "" || req.RoleId == 0 {
-		log.Printf("invalid input: %v", req)
 		return nil, status.Error(codes.InvalidArgument, "login, password and role_id are required")
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), s.bcryptCost)
+	caller, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCanAssignRole(caller, int(req.RoleId)); err != nil {
+		return nil, err
+	}
+
+	if err := s.validatePassword(ctx, "password", req.Password, req.Login, uuid.Nil, ""); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := s.hasher.Hash(req.Password)
 	if err != nil {
 		log.Printf("failed to hash password: %v", err)
 		return nil, status.Error(codes.Internal, "failed to hash password")
@@ -129,22 +191,36 @@ func (s *StaffService) Create(ctx context.Context, req *staff.CreateIn) (*staff.
 
 	permissions := model.Permissions{}
 	if req.Permissions != nil {
+		if err := checkCanChangePermissions(caller, nil, nil, req.Permissions.Access); err != nil {
+			return nil, err
+		}
 		permissions.Access = req.Permissions.Access
 	}
 
 	staffModel := &model.Staff{
 		ID:           uuid.New(),
 		Login:        req.Login,
-		PasswordHash: string(hashedPassword),
+		PasswordHash: hashedPassword,
 		RoleID:       int(req.RoleId),
 		Permissions:  permissions,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
-	}
-
-	if err := s.repo.StaffCreate(ctx, staffModel); err != nil {
-		log.Printf("error: %v", err)
-		return nil, status.Error(codes.Internal, "failed to create staff")
+		// Пароль выбран владельцем, сотрудник сменит его при первом входе
+		MustChangePassword: true,
+		PasswordChangedAt:  time.Now(),
+		IsActive:           true,
+		Version:            1,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	err = s.runInTx(ctx, "failed to create staff", func(repo DbRepo) error {
+		if err := repo.StaffCreate(ctx, staffModel); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionStaffCreate, model.AuditTargetStaff,
+			staffModel.ID.String(), staffChanges(nil, staffModel))
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &staff.CreateOut{
@@ -159,27 +235,63 @@ func (s *StaffService) Update(ctx context.Context, req *staff.UpdateIn) (*staff.
 		return nil, status.Error(codes.InvalidArgument, "invalid staff id")
 	}
 
+	caller, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	staffModel, err := s.repo.StaffGetByID(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get staff")
+		return nil, toStatus(err, "failed to get staff")
 	}
-	if staffModel == nil {
-		return nil, status.Error(codes.NotFound, "staff not found")
+	if staffModel.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "staff is deleted")
 	}
+	if err := checkCanManage(caller, staffModel); err != nil {
+		return nil, err
+	}
+	// Клиент изменял запись, прочитанную раньше последнего изменения
+	if req.Version != nil && *req.Version != staffModel.Version {
+		return nil, toStatus(domainerr.Conflict("staff", staffModel.ID.String()), "failed to update staff")
+	}
+	before := *staffModel
 
 	if req.Login != nil {
 		staffModel.Login = *req.Login
 	}
-	if req.RoleId != nil {
+	if req.RoleId != nil && int(*req.RoleId) != staffModel.RoleID {
+		// Сменить роль можно только сотруднику ниже по иерархии и только на роль ниже собственной
+		if err := checkCanAssignRole(caller, staffModel.RoleID); err != nil {
+			return nil, err
+		}
+		if err := checkCanAssignRole(caller, int(*req.RoleId)); err != nil {
+			return nil, err
+		}
 		staffModel.RoleID = int(*req.RoleId)
 	}
 	if req.Permissions != nil {
+		if err := checkCanChangePermissions(caller, staffModel, staffModel.Permissions.Access, req.Permissions.Access); err != nil {
+			return nil, err
+		}
 		staffModel.Permissions.Access = req.Permissions.Access
 	}
+	if req.MustChangePassword != nil {
+		staffModel.MustChangePassword = *req.MustChangePassword
+	}
 	staffModel.UpdatedAt = time.Now()
 
-	if err := s.repo.StaffUpdate(ctx, staffModel); err != nil {
-		return nil, status.Error(codes.Internal, "failed to update staff")
+	err = s.runInTx(ctx, "failed to update staff", func(repo DbRepo) error {
+		if err := s.ensureOwnerRemains(ctx, repo, &before, staffModel); err != nil {
+			return err
+		}
+		if err := repo.StaffUpdate(ctx, staffModel); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionStaffUpdate, model.AuditTargetStaff,
+			staffModel.ID.String(), staffChanges(&before, staffModel))
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &staff.UpdateOut{
@@ -187,15 +299,26 @@ func (s *StaffService) Update(ctx context.Context, req *staff.UpdateIn) (*staff.
 	}, nil
 }
 
-// DeleteStaff удаляет сотрудника
+// DeleteStaff удаляет сотрудника. Запись сохраняется до окончательного удаления методом Purge,
+// все сессии сотрудника завершаются
 func (s *StaffService) Delete(ctx context.Context, req *staff.DeleteIn) (*staff.DeleteOut, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid staff id")
 	}
 
-	if err := s.repo.StaffDelete(ctx, id); err != nil {
-		return nil, status.Error(codes.Internal, "failed to delete staff")
+	_, err = s.changeStaffState(ctx, id, model.AuditActionStaffDelete, func(staffModel *model.Staff) error {
+		if staffModel.DeletedAt != nil {
+			return status.Error(codes.FailedPrecondition, "staff is already deleted")
+		}
+
+		now := time.Now()
+		staffModel.IsActive = false
+		staffModel.DeletedAt = &now
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &staff.DeleteOut{}, nil
@@ -215,8 +338,9 @@ func (s *StaffService) List(ctx context.Context, req *staff.ListIn) (*staff.List
 	}
 
 	filter := &model.StaffFilter{
-		Page:     page,
-		PageSize: pageSize,
+		Page:            page,
+		PageSize:        pageSize,
+		IncludeInactive: req.IncludeInactive,
 	}
 	if req.SearchTerm != nil {
 		filter.SearchTerm = *req.SearchTerm
@@ -227,7 +351,7 @@ func (s *StaffService) List(ctx context.Context, req *staff.ListIn) (*staff.List
 
 	staffList, total, err := s.repo.StaffList(ctx, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to list staff")
+		return nil, toStatus(err, "failed to list staff")
 	}
 
 	protoStaffList := make([]*staff.Staff, len(staffList))
@@ -256,32 +380,74 @@ func (s *StaffService) Login(ctx context.Context, req *staff.LoginIn) (*staff.Lo
 		return nil, status.Error(codes.InvalidArgument, "login and password are required")
 	}
 
+	ip := peerIP(ctx)
+	locked, err := s.isLoginLocked(ctx, req.Login, ip)
+	if err != nil {
+		log.Printf("failed to check login lockout: %v", err)
+		return nil, status.Error(codes.Internal, "failed to check login lockout")
+	}
+	// Блокировка не зависит от существования логина, поэтому ответ не нужно выравнивать
+	// по времени фиктивным хешированием, которое иначе позволило бы тратить память сервиса без ограничений
+	if locked {
+		return nil, invalidCredentialsError()
+	}
+
 	staffModel, err := s.repo.StaffGetByLogin(ctx, req.Login)
+	if domainerr.IsNotFound(err) {
+		s.compareDummyPassword(req.Password)
+		s.recordLoginFailure(ctx, req.Login, ip)
+		return nil, invalidCredentialsError()
+	}
 	if err != nil {
-		log.Printf("error: %v", err)
-		return nil, status.Error(codes.Internal, "failed to get staff")
+		return nil, toStatus(err, "failed to get staff")
 	}
-	if staffModel == nil {
-		log.Printf("staff not found")
-		return nil, status.Error(codes.NotFound, "staff not found")
+
+	valid, err := s.hasher.Verify(staffModel.PasswordHash, req.Password)
+	if err != nil {
+		log.Printf("failed to verify password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to verify password")
+	}
+	if !valid {
+		s.recordLoginFailure(ctx, req.Login, ip)
+		return nil, invalidCredentialsError()
 	}
+	if !staffModel.Active() {
+		return nil, status.Error(codes.PermissionDenied, "staff account is deactivated")
+	}
+
+	s.upgradePasswordHash(ctx, staffModel, req.Password)
 
-	if err := bcrypt.CompareHashAndPassword([]byte(staffModel.PasswordHash), []byte(req.Password)); err != nil {
-		log.Printf("invalid password")
-		return nil, status.Error(codes.Unauthenticated, "invalid password")
+	if err := s.repo.LoginLockoutClear(ctx, model.LockoutScopeLogin, req.Login); err != nil {
+		log.Printf("failed to clear login lockout: %v", err)
 	}
 
-	session, err := s.createSession(ctx, staffModel.ID)
+	// При подключенном TOTP токены выдаются только после VerifyMFA
+	if staffModel.TOTPEnabled {
+		challenge, err := s.createMFAChallenge(ctx, staffModel.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &staff.LoginOut{
+			MfaRequired:  true,
+			MfaToken:     challenge.Token,
+			MfaExpiresAt: challenge.ExpiresAt.Unix(),
+		}, nil
+	}
+
+	session, err := s.createSession(ctx, s.repo, staffModel, nil)
 	if err != nil {
 		log.Printf("failed to create session: %v", err)
 		return nil, err
 	}
 
 	return &staff.LoginOut{
-		AccessToken:  session.Token,
-		RefreshToken: session.RefreshToken,
-		ExpiresAt:    session.ExpiresAt.Unix(),
-		Staff:        convertStaffToProto(staffModel),
+		AccessToken:            session.Token,
+		RefreshToken:           session.RefreshToken,
+		ExpiresAt:              session.ExpiresAt.Unix(),
+		RefreshExpiresAt:       session.RefreshExpiresAt.Unix(),
+		Staff:                  convertStaffToProto(staffModel),
+		PasswordChangeRequired: session.PasswordChangeRequired,
 	}, nil
 }
 
@@ -292,52 +458,81 @@ func (s *StaffService) RefreshToken(ctx context.Context, req *staff.RefreshToken
 	}
 
 	session, err := s.repo.SessionGetByRefreshToken(ctx, req.RefreshToken)
+	if domainerr.IsNotFound(err) {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get session")
+		return nil, toStatus(err, "failed to get session")
 	}
-	if session == nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+
+	if session.RotatedAt != nil {
+		return nil, s.revokeReusedFamily(ctx, session)
 	}
 
-	if session.ExpiresAt.Before(time.Now()) {
-		if err := s.repo.SessionDelete(ctx, session.Token); err != nil {
-			return nil, status.Error(codes.Internal, "failed to delete expired session")
+	if session.RefreshExpiresAt.Before(time.Now()) {
+		if err := s.repo.SessionDeleteByID(ctx, session.ID); err != nil {
+			return nil, toStatus(err, "failed to delete expired session")
 		}
 		return nil, status.Error(codes.Unauthenticated, "refresh token expired")
 	}
 
-	staffModel, err := s.repo.StaffGetByID(ctx, session.StaffID)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get staff")
-	}
-	if staffModel == nil {
-		return nil, status.Error(codes.NotFound, "staff not found")
+	if s.sessionTimeouts.Expired(s.lastActivity(session), session.AuthenticatedAt, time.Now()) {
+		if err := s.repo.SessionDeleteFamily(ctx, session.FamilyID); err != nil {
+			return nil, toStatus(err, "failed to delete expired session")
+		}
+		return nil, status.Error(codes.Unauthenticated, "session expired")
 	}
 
-	newSession, err := s.createSession(ctx, staffModel.ID)
+	staffModel, err := s.repo.StaffGetByID(ctx, session.StaffID)
+	if err != nil {
+		return nil, toStatus(err, "failed to get staff")
+	}
+
+	// Помечаем старую сессию обмененной до выдачи новой: из двух одновременных
+	// запросов с одним refresh токеном успешным будет только один. Отметка и новая
+	// сессия сохраняются вместе, чтобы сбой не оставил сотрудника без действующего токена
+	var rotated bool
+	var newSession *model.Session
+	err = s.runInTx(ctx, "failed to rotate session", func(repo DbRepo) error {
+		rotated, err = repo.SessionMarkRotated(ctx, session.ID, time.Now())
+		if err != nil || !rotated {
+			return err
+		}
+		newSession, err = s.createSession(ctx, repo, staffModel, session)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	if err := s.repo.SessionDelete(ctx, session.Token); err != nil {
-		return nil, status.Error(codes.Internal, "failed to delete old session")
+	if !rotated {
+		return nil, s.revokeReusedFamily(ctx, session)
 	}
 
 	return &staff.RefreshTokenOut{
-		AccessToken:  newSession.Token,
-		RefreshToken: newSession.RefreshToken,
-		ExpiresAt:    newSession.ExpiresAt.Unix(),
+		AccessToken:            newSession.Token,
+		RefreshToken:           newSession.RefreshToken,
+		ExpiresAt:              newSession.ExpiresAt.Unix(),
+		RefreshExpiresAt:       newSession.RefreshExpiresAt.Unix(),
+		PasswordChangeRequired: newSession.PasswordChangeRequired,
 	}, nil
 }
 
-// Logout выход из системы и завершение сессии
-func (s *StaffService) Logout(ctx context.Context, req *staff.LogoutIn) (*staff.LogoutOut, error) {
-	if req.AccessToken == "" {
-		return nil, status.Error(codes.InvalidArgument, "access token is required")
+// Logout выход из системы и завершение текущей сессии
+func (s *StaffService) Logout(ctx context.Context, _ *staff.LogoutIn) (*staff.LogoutOut, error) {
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.repo.SessionDelete(ctx, req.AccessToken); err != nil {
-		return nil, status.Error(codes.Internal, "failed to delete session")
+	err = s.runInTx(ctx, "failed to delete session", func(repo DbRepo) error {
+		if err := repo.SessionDeleteByID(ctx, current.SessionID); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &current.StaffID, model.AuditActionLogout, model.AuditTargetSession,
+			current.SessionID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &staff.LogoutOut{
@@ -345,125 +540,501 @@ func (s *StaffService) Logout(ctx context.Context, req *staff.LogoutIn) (*staff.
 	}, nil
 }
 
-// CheckAuth проверка текущего статуса авторизации
-func (s *StaffService) CheckAuth(ctx context.Context, req *staff.CheckAuthIn) (*staff.CheckAuthOut, error) {
-	if req.AccessToken == "" {
-		return nil, status.Error(codes.InvalidArgument, "access token is required")
-	}
-
-	session, err := s.repo.SessionGetByToken(ctx, req.AccessToken)
+// CheckAuth проверка текущего статуса авторизации.
+// Недействительный токен отклоняется AuthInterceptor до вызова метода
+func (s *StaffService) CheckAuth(ctx context.Context, _ *staff.CheckAuthIn) (*staff.CheckAuthOut, error) {
+	current, err := s.currentPrincipal(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get session")
+		return nil, err
 	}
-	if session == nil {
+
+	staffModel, err := s.repo.StaffGetByID(ctx, current.StaffID)
+	if domainerr.IsNotFound(err) {
 		return &staff.CheckAuthOut{
 			Authorized: false,
 		}, nil
 	}
+	if err != nil {
+		return nil, toStatus(err, "failed to get staff")
+	}
+
+	return &staff.CheckAuthOut{
+		Authorized:             !current.PasswordChangeRequired,
+		Staff:                  convertStaffToProto(staffModel),
+		PasswordChangeRequired: current.PasswordChangeRequired,
+	}, nil
+}
+
+// ChangePassword изменение пароля авторизованного пользователя
+func (s *StaffService) ChangePassword(ctx context.Context, req *staff.ChangePasswordIn) (*staff.ChangePasswordOut, error) {
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "old_password and new_password are required")
+	}
+
+	staffModel, err := s.currentStaff(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if session.ExpiresAt.Before(time.Now()) {
-		if err := s.repo.SessionDelete(ctx, session.Token); err != nil {
-			return nil, status.Error(codes.Internal, "failed to delete expired session")
+	valid, err := s.hasher.Verify(staffModel.PasswordHash, req.OldPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify password")
+	}
+	if !valid {
+		return nil, status.Error(codes.Unauthenticated, "invalid old password")
+	}
+
+	if err := s.validatePassword(ctx, "new_password", req.NewPassword, staffModel.Login, staffModel.ID, staffModel.PasswordHash); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := s.hasher.Hash(req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	err = s.runInTx(ctx, "failed to change password", func(repo DbRepo) error {
+		if err := s.rememberPassword(ctx, repo, staffModel); err != nil {
+			return err
 		}
-		return &staff.CheckAuthOut{
-			Authorized: false,
-		}, nil
+
+		staffModel.PasswordHash = hashedPassword
+		staffModel.MustChangePassword = false
+		staffModel.PasswordChangedAt = time.Now()
+		staffModel.UpdatedAt = time.Now()
+
+		if err := repo.StaffUpdate(ctx, staffModel); err != nil {
+			return err
+		}
+		if err := repo.SessionDeleteAllForStaff(ctx, staffModel.ID); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &staffModel.ID, model.AuditActionPasswordChange, model.AuditTargetStaff,
+			staffModel.ID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	staffModel, err := s.repo.StaffGetByID(ctx, session.StaffID)
+	return &staff.ChangePasswordOut{
+		Success: true,
+	}, nil
+}
+
+// GetSigningKeys получение открытых ключей для проверки JWT access токенов
+func (s *StaffService) GetSigningKeys(_ context.Context, _ *staff.GetSigningKeysIn) (*staff.GetSigningKeysOut, error) {
+	if s.signer == nil {
+		return &staff.GetSigningKeysOut{}, nil
+	}
+
+	publicKeys := s.signer.PublicKeys()
+	keys := make([]*staff.JsonWebKey, len(publicKeys))
+	for i, key := range publicKeys {
+		keys[i] = &staff.JsonWebKey{
+			Kid: key.KeyID,
+			Kty: key.KeyType,
+			Alg: key.Algorithm,
+			Use: key.Use,
+			Crv: key.Curve,
+			X:   key.X,
+			N:   key.N,
+			E:   key.E,
+		}
+	}
+
+	return &staff.GetSigningKeysOut{
+		Keys: keys,
+	}, nil
+}
+
+// ===== Реализация методов управления ролями =====
+
+// ListRoles получает список ролей
+func (s *StaffService) ListRoles(ctx context.Context, _ *staff.ListRolesIn) (*staff.ListRolesOut, error) {
+	roles, err := s.repo.RoleList(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get staff")
+		return nil, toStatus(err, "failed to list roles")
 	}
-	if staffModel == nil {
-		return &staff.CheckAuthOut{
-			Authorized: false,
-		}, nil
+
+	protoRoles := make([]*staff.Role, len(roles))
+	for i, role := range roles {
+		protoRoles[i] = convertRoleToProto(role)
 	}
 
-	return &staff.CheckAuthOut{
-		Authorized: true,
-		Staff:      convertStaffToProto(staffModel),
+	return &staff.ListRolesOut{
+		Roles: protoRoles,
 	}, nil
 }
 
-// ChangePassword изменение пароля авторизованного пользователя
-func (s *StaffService) ChangePassword(ctx context.Context, req *staff.ChangePasswordIn) (*staff.ChangePasswordOut, error) {
-	if req.OldPassword == "" || req.NewPassword == "" || req.AccessToken == "" {
-		return nil, status.Error(codes.InvalidArgument, "old_password, new_password and access_token are required")
+// GetRole получает информацию о роли по ID
+func (s *StaffService) GetRole(ctx context.Context, req *staff.GetRoleIn) (*staff.GetRoleOut, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid role id")
+	}
+
+	role, err := s.repo.RoleGetByID(ctx, int(req.Id))
+	if err != nil {
+		return nil, toStatus(err, "failed to get role")
+	}
+
+	return &staff.GetRoleOut{
+		Role: convertRoleToProto(role),
+	}, nil
+}
+
+// CreateRole создает новую роль
+func (s *StaffService) CreateRole(ctx context.Context, req *staff.CreateRoleIn) (*staff.CreateRoleOut, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
 	}
 
-	session, err := s.repo.SessionGetByToken(ctx, req.AccessToken)
+	role := &model.Role{
+		Name: req.Name,
+	}
+
+	err := s.runInTx(ctx, "failed to create role", func(repo DbRepo) error {
+		if err := repo.RoleCreate(ctx, role); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionRoleCreate, model.AuditTargetRole,
+			strconv.Itoa(role.ID), map[string]model.AuditChange{"name": {After: role.Name}})
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get session")
+		return nil, err
+	}
+
+	return &staff.CreateRoleOut{
+		Role: convertRoleToProto(role),
+	}, nil
+}
+
+// UpdateRole переименовывает роль
+func (s *StaffService) UpdateRole(ctx context.Context, req *staff.UpdateRoleIn) (*staff.UpdateRoleOut, error) {
+	if req.Id <= 0 || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "id and name are required")
 	}
-	if session == nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	if model.IsSystemRole(int(req.Id)) {
+		return nil, status.Error(codes.FailedPrecondition, "system role cannot be changed")
 	}
 
-	if session.ExpiresAt.Before(time.Now()) {
-		if err := s.repo.SessionDelete(ctx, session.Token); err != nil {
-			return nil, status.Error(codes.Internal, "failed to delete expired session")
+	role := &model.Role{
+		ID:   int(req.Id),
+		Name: req.Name,
+	}
+
+	err := s.runInTx(ctx, "failed to update role", func(repo DbRepo) error {
+		before, err := repo.RoleGetByID(ctx, role.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.RoleUpdate(ctx, role); err != nil {
+			return err
 		}
-		return nil, status.Error(codes.Unauthenticated, "access token expired")
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionRoleUpdate, model.AuditTargetRole,
+			strconv.Itoa(role.ID), diffFields(map[string]interface{}{"name": before.Name}, map[string]interface{}{"name": role.Name}))
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	staffModel, err := s.repo.StaffGetByID(ctx, session.StaffID)
+	return &staff.UpdateRoleOut{
+		Role: convertRoleToProto(role),
+	}, nil
+}
+
+// DeleteRole удаляет роль
+func (s *StaffService) DeleteRole(ctx context.Context, req *staff.DeleteRoleIn) (*staff.DeleteRoleOut, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid role id")
+	}
+	if model.IsSystemRole(int(req.Id)) {
+		return nil, status.Error(codes.FailedPrecondition, "system role cannot be deleted")
+	}
+
+	assigned, err := s.repo.RoleIsAssigned(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get staff")
+		return nil, toStatus(err, "failed to check role usage")
 	}
-	if staffModel == nil {
-		return nil, status.Error(codes.NotFound, "staff not found")
+	if assigned {
+		return nil, status.Error(codes.FailedPrecondition, "role is assigned to staff")
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(staffModel.PasswordHash), []byte(req.OldPassword)); err != nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid old password")
+	err = s.runInTx(ctx, "failed to delete role", func(repo DbRepo) error {
+		if err := repo.RoleDelete(ctx, int(req.Id)); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionRoleDelete, model.AuditTargetRole,
+			strconv.Itoa(int(req.Id)), nil)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), s.bcryptCost)
+	return &staff.DeleteRoleOut{
+		Success: true,
+	}, nil
+}
+
+// ===== Реализация методов управления политиками доступа =====
+
+// ListAccessPolicies получает список политик доступа к методам
+func (s *StaffService) ListAccessPolicies(ctx context.Context, _ *staff.ListAccessPoliciesIn) (*staff.ListAccessPoliciesOut, error) {
+	policies, err := s.repo.AccessPolicyList(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to hash password")
+		return nil, toStatus(err, "failed to list access policies")
 	}
 
-	staffModel.PasswordHash = string(hashedPassword)
-	staffModel.UpdatedAt = time.Now()
+	protoPolicies := make([]*staff.AccessPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = convertAccessPolicyToProto(policy)
+	}
 
-	if err := s.repo.StaffUpdate(ctx, staffModel); err != nil {
-		return nil, status.Error(codes.Internal, "failed to update staff")
+	return &staff.ListAccessPoliciesOut{
+		Policies: protoPolicies,
+	}, nil
+}
+
+// SetAccessPolicy создает или изменяет политику доступа к методу. Кеш политик этого экземпляра
+// перечитывается сразу, остальные экземпляры применят изменение в течение периода перечитывания
+func (s *StaffService) SetAccessPolicy(ctx context.Context, req *staff.SetAccessPolicyIn) (*staff.SetAccessPolicyOut, error) {
+	if req.Policy == nil || req.Policy.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "policy method is required")
+	}
+	if !isServiceMethod(req.Policy.Method) {
+		return nil, status.Error(codes.InvalidArgument, "unknown method")
 	}
 
-	if err := s.repo.SessionDeleteAllForStaff(ctx, staffModel.ID); err != nil {
-		return nil, status.Error(codes.Internal, "failed to delete sessions")
+	roles := make([]int, len(req.Policy.RoleIds))
+	for i, roleID := range req.Policy.RoleIds {
+		roles[i] = int(roleID)
 	}
 
-	return &staff.ChangePasswordOut{
+	policy := &model.AccessPolicy{
+		Method:      req.Policy.Method,
+		Roles:       roles,
+		Permissions: req.Policy.Permissions,
+		UpdatedAt:   time.Now(),
+	}
+
+	err := s.runInTx(ctx, "failed to set access policy", func(repo DbRepo) error {
+		if err := repo.AccessPolicyUpsert(ctx, policy); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionAccessPolicySet, model.AuditTargetAccessPolicy,
+			policy.Method, map[string]model.AuditChange{
+				"roles":       {After: policy.Roles},
+				"permissions": {After: policy.Permissions},
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reloadPolicies(ctx)
+
+	return &staff.SetAccessPolicyOut{
+		Policy: convertAccessPolicyToProto(policy),
+	}, nil
+}
+
+// DeleteAccessPolicy удаляет политику доступа к методу. Кеш политик этого экземпляра
+// перечитывается сразу, остальные экземпляры применят изменение в течение периода перечитывания
+func (s *StaffService) DeleteAccessPolicy(ctx context.Context, req *staff.DeleteAccessPolicyIn) (*staff.DeleteAccessPolicyOut, error) {
+	if req.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "method is required")
+	}
+
+	err := s.runInTx(ctx, "failed to delete access policy", func(repo DbRepo) error {
+		if err := repo.AccessPolicyDelete(ctx, req.Method); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionAccessPolicyDelete, model.AuditTargetAccessPolicy,
+			req.Method, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reloadPolicies(ctx)
+
+	return &staff.DeleteAccessPolicyOut{
 		Success: true,
 	}, nil
 }
 
 // ===== Вспомогательные методы =====
 
-// createSession создает новую сессию для сотрудника
-func (s *StaffService) createSession(ctx context.Context, staffID uuid.UUID) (*model.Session, error) {
+// lastActivity возвращает время последней активности сессии с учетом отметок, еще не записанных в базу
+func (s *StaffService) lastActivity(session *model.Session) time.Time {
+	lastActivityAt := session.LastActivityAt
+	if s.activity == nil {
+		return lastActivityAt
+	}
+	if pending, ok := s.activity.LastActivity(session.ID); ok && pending.After(lastActivityAt) {
+		lastActivityAt = pending
+	}
+	return lastActivityAt
+}
+
+// reloadPolicies перечитывает кеш политик доступа после их изменения. Изменение уже сохранено,
+// поэтому ошибка только записывается в лог: кеш обновится при следующем периодическом перечитывании
+func (s *StaffService) reloadPolicies(ctx context.Context) {
+	if s.policies == nil {
+		return
+	}
+	if err := s.policies.Reload(ctx); err != nil {
+		log.Printf("failed to reload access policies: %v", err)
+	}
+}
+
+// createSession создает новую сессию для сотрудника через repo: при вызове из транзакции
+// сессия создается в ней. parent сессия, обмененная на новую при обновлении refresh токена, nil при входе
+func (s *StaffService) createSession(ctx context.Context, repo DbRepo, staffModel *model.Staff, parent *model.Session) (*model.Session, error) {
+	if !staffModel.Active() {
+		return nil, status.Error(codes.PermissionDenied, "staff account is deactivated")
+	}
+
+	now := time.Now()
 	session := &model.Session{
-		ID:             uuid.New(),
-		StaffID:        staffID,
-		Token:          generateToken(),
-		RefreshToken:   generateToken(),
-		ExpiresAt:      time.Now().Add(s.accessTokenTTL),
-		CreatedAt:      time.Now(),
-		LastActivityAt: time.Now(),
+		ID:                     uuid.New(),
+		StaffID:                staffModel.ID,
+		FamilyID:               uuid.New(),
+		Token:                  generateToken(),
+		RefreshToken:           generateToken(),
+		ExpiresAt:              now.Add(s.accessTokenTTL),
+		RefreshExpiresAt:       now.Add(s.refreshTokenTTL),
+		CreatedAt:              now,
+		LastActivityAt:         now,
+		AuthenticatedAt:        now,
+		PasswordChangeRequired: s.passwordChangeRequired(staffModel, now),
+		UserAgent:              userAgent(ctx),
+		IP:                     peerIP(ctx),
+	}
+	if parent != nil {
+		session.FamilyID = parent.FamilyID
+		session.AuthenticatedAt = parent.AuthenticatedAt
+	}
+
+	// Сессия не продлевается дальше максимального времени жизни
+	if deadline := s.sessionTimeouts.Deadline(session.AuthenticatedAt); !deadline.IsZero() {
+		if session.ExpiresAt.After(deadline) {
+			session.ExpiresAt = deadline
+		}
+		if session.RefreshExpiresAt.After(deadline) {
+			session.RefreshExpiresAt = deadline
+		}
 	}
 
-	if err := s.repo.SessionCreate(ctx, session); err != nil {
-		return nil, status.Error(codes.Internal, "failed to create session")
+	if s.signer != nil {
+		token, err := s.signer.Sign(&model.AccessClaims{
+			StaffID:                staffModel.ID,
+			SessionID:              session.ID,
+			RoleID:                 staffModel.RoleID,
+			Permissions:            staffModel.Permissions.Access,
+			ExpiresAt:              session.ExpiresAt,
+			PasswordChangeRequired: session.PasswordChangeRequired,
+		})
+		if err != nil {
+			log.Printf("failed to sign access token: %v", err)
+			return nil, status.Error(codes.Internal, "failed to sign access token")
+		}
+		session.Token = token
+	}
+
+	// Обновление refresh токена не добавляет сессию, ограничение проверяется только при входе
+	err := repo.WithTx(ctx, func(repo DbRepo) error {
+		if parent != nil {
+			return repo.SessionCreate(ctx, session)
+		}
+
+		if err := s.enforceSessionLimit(ctx, repo, staffModel); err != nil {
+			return err
+		}
+		if err := repo.SessionCreate(ctx, session); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &staffModel.ID, model.AuditActionLogin, model.AuditTargetSession,
+			session.ID.String(), nil)
+	})
+	if err != nil {
+		return nil, toStatus(err, "failed to create session")
 	}
 
 	return session, nil
 }
 
+// upgradePasswordHash пересчитывает хеш пароля после успешного входа,
+// если он вычислен более слабым алгоритмом или с меньшими параметрами
+func (s *StaffService) upgradePasswordHash(ctx context.Context, staffModel *model.Staff, password string) {
+	if !s.hasher.NeedsRehash(staffModel.PasswordHash) {
+		return
+	}
+
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		log.Printf("failed to rehash password: %v", err)
+		return
+	}
+
+	if err := s.repo.StaffUpdatePasswordHash(ctx, staffModel.ID, staffModel.PasswordHash, hash); err != nil {
+		log.Printf("failed to save rehashed password: %v", err)
+		return
+	}
+	staffModel.PasswordHash = hash
+}
+
+// currentStaff получает сотрудника, от имени которого выполняется запрос
+func (s *StaffService) currentStaff(ctx context.Context) (*model.Staff, error) {
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	staffModel, err := s.repo.StaffGetByID(ctx, current.StaffID)
+	if err != nil {
+		return nil, toStatus(err, "failed to get staff")
+	}
+
+	return staffModel, nil
+}
+
+// currentPrincipal получает сотрудника и сессию, определенные AuthInterceptor
+func (s *StaffService) currentPrincipal(ctx context.Context) (*principal.Principal, error) {
+	current, ok := principal.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	return current, nil
+}
+
+// revokeReusedFamily отзывает все сессии семейства при повторном использовании
+// обмененного refresh токена и фиксирует событие безопасности
+func (s *StaffService) revokeReusedFamily(ctx context.Context, session *model.Session) error {
+	log.Printf("refresh token reuse detected for staff %s, family %s", session.StaffID, session.FamilyID)
+
+	if err := s.repo.SessionDeleteFamily(ctx, session.FamilyID); err != nil {
+		return toStatus(err, "failed to revoke session family")
+	}
+
+	event := &model.SecurityEvent{
+		ID:      uuid.New(),
+		StaffID: session.StaffID,
+		Type:    model.SecurityEventRefreshTokenReuse,
+		Details: map[string]string{
+			"family_id":  session.FamilyID.String(),
+			"session_id": session.ID.String(),
+		},
+		CreatedAt: time.Now(),
+	}
+	if err := s.repo.SecurityEventCreate(ctx, event); err != nil {
+		log.Printf("failed to create security event: %v", err)
+	}
+
+	return status.Error(codes.Unauthenticated, "refresh token has already been used")
+}
+
 // convertStaffToProto преобразует модель Staff в proto-сообщение
 func convertStaffToProto(staffModel *model.Staff) *staff.Staff {
-	return &staff.Staff{
+	protoStaff := &staff.Staff{
 		Id:       staffModel.ID.String(),
 		Login:    staffModel.Login,
 		RoleId:   int32(staffModel.RoleID),
@@ -471,9 +1042,53 @@ func convertStaffToProto(staffModel *model.Staff) *staff.Staff {
 		Permissions: &staff.Permissions{
 			Access: staffModel.Permissions.Access,
 		},
-		CreatedAt: staffModel.CreatedAt.Unix(),
-		UpdatedAt: staffModel.UpdatedAt.Unix(),
+		CreatedAt:          staffModel.CreatedAt.Unix(),
+		UpdatedAt:          staffModel.UpdatedAt.Unix(),
+		TotpEnabled:        staffModel.TOTPEnabled,
+		MustChangePassword: staffModel.MustChangePassword,
+		PasswordChangedAt:  staffModel.PasswordChangedAt.Unix(),
+		IsActive:           staffModel.IsActive,
+		Version:            staffModel.Version,
+	}
+	if staffModel.DeletedAt != nil {
+		deletedAt := staffModel.DeletedAt.Unix()
+		protoStaff.DeletedAt = &deletedAt
+	}
+
+	return protoStaff
+}
+
+// convertRoleToProto преобразует модель Role в proto-сообщение
+func convertRoleToProto(role *model.Role) *staff.Role {
+	return &staff.Role{
+		Id:   int32(role.ID),
+		Name: role.Name,
+	}
+}
+
+// convertAccessPolicyToProto преобразует модель AccessPolicy в proto-сообщение
+func convertAccessPolicyToProto(policy *model.AccessPolicy) *staff.AccessPolicy {
+	roleIDs := make([]int32, len(policy.Roles))
+	for i, role := range policy.Roles {
+		roleIDs[i] = int32(role)
+	}
+
+	return &staff.AccessPolicy{
+		Method:      policy.Method,
+		RoleIds:     roleIDs,
+		Permissions: policy.Permissions,
+		UpdatedAt:   policy.UpdatedAt.Unix(),
+	}
+}
+
+// isServiceMethod проверяет, что метод объявлен в StaffService
+func isServiceMethod(fullMethod string) bool {
+	for _, method := range staff.StaffService_ServiceDesc.Methods {
+		if fullMethod == "/"+staff.StaffService_ServiceDesc.ServiceName+"/"+method.MethodName {
+			return true
+		}
 	}
+	return false
 }
 
 // generateToken генерирует случайный токен
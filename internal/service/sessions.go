@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/principal"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// Действия при достижении ограничения числа одновременных сессий
+const (
+	// SessionLimitPolicyReject новый вход отклоняется
+	SessionLimitPolicyReject = "reject"
+	// SessionLimitPolicyEvictOldest завершается сессия с самым ранним входом
+	SessionLimitPolicyEvictOldest = "evict_oldest"
+)
+
+// SessionLimit ограничение числа одновременных сессий сотрудника
+type SessionLimit struct {
+	MaxSessions int         // для всех ролей, 0 - без ограничения
+	MaxByRole   map[int]int // переопределение MaxSessions для отдельных ролей
+	Policy      string      // SessionLimitPolicyReject или SessionLimitPolicyEvictOldest
+}
+
+// Max возвращает ограничение для роли, 0 - без ограничения
+func (l SessionLimit) Max(roleID int) int {
+	if limit, ok := l.MaxByRole[roleID]; ok {
+		return limit
+	}
+	return l.MaxSessions
+}
+
+// Validate проверяет, что действие при достижении ограничения известно
+func (l SessionLimit) Validate() error {
+	switch l.Policy {
+	case SessionLimitPolicyReject, SessionLimitPolicyEvictOldest:
+		return nil
+	default:
+		return fmt.Errorf("unknown session limit policy %q", l.Policy)
+	}
+}
+
+// WithSessionLimit устанавливает ограничение числа одновременных сессий сотрудника
+func WithSessionLimit(limit SessionLimit) ServiceOption {
+	return func(s *StaffService) {
+		s.sessionLimit = limit
+	}
+}
+
+// ===== Реализация методов управления сессиями =====
+
+// ListMySessions получение активных сессий текущего сотрудника
+func (s *StaffService) ListMySessions(ctx context.Context, _ *staff.ListMySessionsIn) (*staff.ListMySessionsOut, error) {
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.SessionListForStaff(ctx, current.StaffID)
+	if err != nil {
+		return nil, toStatus(err, "failed to list sessions")
+	}
+
+	return &staff.ListMySessionsOut{
+		Sessions: convertSessionsToProto(sessions, current.SessionID),
+	}, nil
+}
+
+// RevokeSession завершение одной из сессий текущего сотрудника
+func (s *StaffService) RevokeSession(ctx context.Context, req *staff.RevokeSessionIn) (*staff.RevokeSessionOut, error) {
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session_id format")
+	}
+
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.runInTx(ctx, "failed to revoke session", func(repo DbRepo) error {
+		revoked, err := repo.SessionRevokeForStaff(ctx, current.StaffID, sessionID)
+		if err != nil {
+			return err
+		}
+		if !revoked {
+			return status.Error(codes.NotFound, "session not found")
+		}
+		return s.audit(ctx, repo, &current.StaffID, model.AuditActionSessionRevoke, model.AuditTargetSession,
+			sessionID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.RevokeSessionOut{
+		Success: true,
+	}, nil
+}
+
+// RevokeAllOtherSessions завершение всех сессий текущего сотрудника, кроме текущей
+func (s *StaffService) RevokeAllOtherSessions(ctx context.Context, _ *staff.RevokeAllOtherSessionsIn) (*staff.RevokeAllOtherSessionsOut, error) {
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	err = s.runInTx(ctx, "failed to revoke sessions", func(repo DbRepo) error {
+		count, err = repo.SessionDeleteOthersForStaff(ctx, current.StaffID, current.FamilyID)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &current.StaffID, model.AuditActionSessionRevokeOthers, model.AuditTargetStaff,
+			current.StaffID.String(), map[string]model.AuditChange{"revoked": {After: count}})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.RevokeAllOtherSessionsOut{
+		RevokedCount: int32(count),
+	}, nil
+}
+
+// ListStaffSessions получение активных сессий сотрудника
+func (s *StaffService) ListStaffSessions(ctx context.Context, req *staff.ListStaffSessionsIn) (*staff.ListStaffSessionsOut, error) {
+	staffID, err := uuid.Parse(req.StaffId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid staff_id format")
+	}
+
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanManageStaff(ctx, s.repo, current, staffID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.SessionListForStaff(ctx, staffID)
+	if err != nil {
+		return nil, toStatus(err, "failed to list sessions")
+	}
+
+	return &staff.ListStaffSessionsOut{
+		Sessions: convertSessionsToProto(sessions, current.SessionID),
+	}, nil
+}
+
+// RevokeStaffSessions завершение всех сессий сотрудника
+func (s *StaffService) RevokeStaffSessions(ctx context.Context, req *staff.RevokeStaffSessionsIn) (*staff.RevokeStaffSessionsOut, error) {
+	staffID, err := uuid.Parse(req.StaffId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid staff_id format")
+	}
+
+	current, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.runInTx(ctx, "failed to delete sessions", func(repo DbRepo) error {
+		if err := s.checkCanManageStaff(ctx, repo, current, staffID); err != nil {
+			return err
+		}
+		if err := repo.SessionDeleteAllForStaff(ctx, staffID); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &current.StaffID, model.AuditActionSessionRevokeAll, model.AuditTargetStaff,
+			staffID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.RevokeStaffSessionsOut{
+		Success: true,
+	}, nil
+}
+
+// checkCanManageStaff загружает сотрудника и проверяет, что он не выше вызывающего по иерархии ролей
+func (s *StaffService) checkCanManageStaff(ctx context.Context, repo DbRepo, caller *principal.Principal, staffID uuid.UUID) error {
+	staffModel, err := repo.StaffGetByID(ctx, staffID)
+	if err != nil {
+		return toStatus(err, "failed to get staff")
+	}
+	return checkCanManage(caller, staffModel)
+}
+
+// enforceSessionLimit проверяет ограничение числа сессий перед созданием новой.
+// Вызывается в транзакции: запись сотрудника блокируется, чтобы параллельные входы
+// не превысили ограничение
+func (s *StaffService) enforceSessionLimit(ctx context.Context, repo DbRepo, staffModel *model.Staff) error {
+	limit := s.sessionLimit.Max(staffModel.RoleID)
+	if limit <= 0 {
+		return nil
+	}
+
+	if err := repo.SessionLockStaff(ctx, staffModel.ID); err != nil {
+		return toStatus(err, "failed to lock staff")
+	}
+
+	sessions, err := repo.SessionListForStaff(ctx, staffModel.ID)
+	if err != nil {
+		return toStatus(err, "failed to list sessions")
+	}
+
+	now := time.Now()
+	active := sessions[:0]
+	for _, session := range sessions {
+		if !s.sessionTimeouts.Expired(session.LastActivityAt, session.AuthenticatedAt, now) {
+			active = append(active, session)
+		}
+	}
+	if len(active) < limit {
+		return nil
+	}
+
+	switch s.sessionLimit.Policy {
+	case SessionLimitPolicyReject:
+		return status.Error(codes.ResourceExhausted, fmt.Sprintf("active session limit of %d reached", limit))
+	case SessionLimitPolicyEvictOldest:
+	default:
+		log.Printf("unknown session limit policy %q", s.sessionLimit.Policy)
+		return status.Error(codes.Internal, "failed to apply session limit")
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].AuthenticatedAt.Before(active[j].AuthenticatedAt)
+	})
+	for _, session := range active[:len(active)-limit+1] {
+		if err := repo.SessionDeleteFamily(ctx, session.FamilyID); err != nil {
+			return toStatus(err, "failed to delete session")
+		}
+	}
+
+	return nil
+}
+
+// userAgent возвращает User-Agent клиента из метаданных запроса
+func userAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("user-agent")
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// convertSessionsToProto преобразует сессии в proto-сообщения, отмечая сессию currentID
+func convertSessionsToProto(sessions []*model.Session, currentID uuid.UUID) []*staff.Session {
+	protoSessions := make([]*staff.Session, len(sessions))
+	for i, session := range sessions {
+		protoSessions[i] = &staff.Session{
+			Id:             session.ID.String(),
+			UserAgent:      session.UserAgent,
+			Ip:             session.IP,
+			CreatedAt:      session.CreatedAt.Unix(),
+			LastActivityAt: session.LastActivityAt.Unix(),
+			ExpiresAt:      session.RefreshExpiresAt.Unix(),
+			Current:        session.ID == currentID,
+		}
+	}
+
+	return protoSessions
+}
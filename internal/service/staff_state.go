@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/model"
+	"github.com/s21platform/staff-service/internal/principal"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// DefaultPurgeRetention срок хранения удаленного сотрудника до окончательного удаления по умолчанию
+const DefaultPurgeRetention = 30 * 24 * time.Hour
+
+// WithPurgeRetention устанавливает срок хранения удаленного сотрудника
+func WithPurgeRetention(retention time.Duration) ServiceOption {
+	return func(s *StaffService) {
+		s.purgeRetention = retention
+	}
+}
+
+// ===== Реализация методов отключения и удаления учетных записей =====
+
+// Deactivate отключение учетной записи сотрудника с завершением всех его сессий
+func (s *StaffService) Deactivate(ctx context.Context, req *staff.DeactivateIn) (*staff.DeactivateOut, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid staff id")
+	}
+
+	staffModel, err := s.changeStaffState(ctx, id, model.AuditActionStaffDeactivate, func(staffModel *model.Staff) error {
+		if staffModel.DeletedAt != nil {
+			return status.Error(codes.FailedPrecondition, "staff is deleted")
+		}
+		if !staffModel.IsActive {
+			return status.Error(codes.FailedPrecondition, "staff is already deactivated")
+		}
+
+		staffModel.IsActive = false
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.DeactivateOut{
+		Staff: convertStaffToProto(staffModel),
+	}, nil
+}
+
+// Reactivate восстановление отключенной или удаленной учетной записи сотрудника
+func (s *StaffService) Reactivate(ctx context.Context, req *staff.ReactivateIn) (*staff.ReactivateOut, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid staff id")
+	}
+
+	staffModel, err := s.changeStaffState(ctx, id, model.AuditActionStaffReactivate, func(staffModel *model.Staff) error {
+		if staffModel.Active() {
+			return status.Error(codes.FailedPrecondition, "staff is already active")
+		}
+
+		staffModel.IsActive = true
+		staffModel.DeletedAt = nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.ReactivateOut{
+		Staff: convertStaffToProto(staffModel),
+	}, nil
+}
+
+// Purge окончательное удаление сотрудника, удаленного раньше срока хранения
+func (s *StaffService) Purge(ctx context.Context, req *staff.PurgeIn) (*staff.PurgeOut, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid staff id")
+	}
+
+	err = s.runInTx(ctx, "failed to purge staff", func(repo DbRepo) error {
+		staffModel, err := repo.StaffGetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if staffModel.DeletedAt == nil {
+			return status.Error(codes.FailedPrecondition, "staff is not deleted")
+		}
+		if staffModel.DeletedAt.Add(s.purgeRetention).After(time.Now()) {
+			return status.Error(codes.FailedPrecondition, "staff retention period has not expired")
+		}
+
+		if err := repo.StaffDelete(ctx, id); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, principal.StaffID(ctx), model.AuditActionStaffPurge, model.AuditTargetStaff,
+			id.String(), staffChanges(staffModel, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.PurgeOut{
+		Success: true,
+	}, nil
+}
+
+// ===== Вспомогательные методы отключения учетных записей =====
+
+// changeStaffState изменяет состояние учетной записи сотрудника в транзакции с записью в журнал аудита.
+// Сессии отключенного сотрудника завершаются. Отключить собственную учетную запись, учетную запись
+// сотрудника с более высокой ролью или последнего активного владельца нельзя
+func (s *StaffService) changeStaffState(ctx context.Context, id uuid.UUID, action string, change func(staffModel *model.Staff) error) (*model.Staff, error) {
+	caller, err := s.currentPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if caller.StaffID == id {
+		return nil, status.Error(codes.FailedPrecondition, "cannot change state of own account")
+	}
+
+	var staffModel *model.Staff
+	err = s.runInTx(ctx, "failed to update staff", func(repo DbRepo) error {
+		var err error
+		staffModel, err = repo.StaffGetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkCanManage(caller, staffModel); err != nil {
+			return err
+		}
+
+		before := *staffModel
+		if err := change(staffModel); err != nil {
+			return err
+		}
+		staffModel.UpdatedAt = time.Now()
+
+		if err := s.ensureOwnerRemains(ctx, repo, &before, staffModel); err != nil {
+			return err
+		}
+
+		if err := repo.StaffUpdate(ctx, staffModel); err != nil {
+			return err
+		}
+		if !staffModel.Active() {
+			if err := repo.SessionDeleteAllForStaff(ctx, id); err != nil {
+				return err
+			}
+		}
+		return s.audit(ctx, repo, &caller.StaffID, action, model.AuditTargetStaff,
+			id.String(), staffChanges(&before, staffModel))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return staffModel, nil
+}
@@ -0,0 +1,508 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/s21platform/staff-service/internal/domainerr"
+	"github.com/s21platform/staff-service/internal/model"
+	staff "github.com/s21platform/staff-service/pkg/staff"
+)
+
+// Параметры TOTP (RFC 6238) и второго фактора
+const (
+	// totpPeriod длительность шага TOTP в секундах
+	totpPeriod = 30
+	// totpDigits количество цифр в коде
+	totpDigits = 6
+	// totpSkew количество соседних шагов, коды которых тоже принимаются
+	totpSkew = 1
+	// totpSecretSize размер секрета в байтах
+	totpSecretSize = 20
+	// recoveryCodeCount количество выдаваемых резервных кодов
+	recoveryCodeCount = 10
+	// DefaultMFAChallengeTTL срок действия mfa_token
+	DefaultMFAChallengeTTL = 5 * time.Minute
+	// maxMFAAttempts количество неверных кодов, после которого mfa_token аннулируется
+	maxMFAAttempts = 5
+	// DefaultTOTPIssuer название сервиса в приложении-аутентификаторе
+	DefaultTOTPIssuer = "s21platform staff"
+	// totpSecretKeySize размер ключа шифрования секретов TOTP (AES-256)
+	totpSecretKeySize = 32
+	// sealedTOTPSecretPrefix отличает зашифрованный секрет от секрета base32, сохраненного до шифрования
+	sealedTOTPSecretPrefix = "v1:"
+)
+
+var (
+	// ErrInvalidTOTPSecretKey возвращается, если ключ шифрования секретов TOTP имеет неверный размер
+	ErrInvalidTOTPSecretKey = errors.New("totp secret key must be 32 bytes")
+	// errTOTPCipherNotConfigured возвращается, если секрет нужно зашифровать, а ключ не задан
+	errTOTPCipherNotConfigured = errors.New("totp secret cipher is not configured")
+)
+
+// NewTOTPSecretCipher создает шифр AES-256-GCM для секретов TOTP
+func NewTOTPSecretCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != totpSecretKeySize {
+		return nil, ErrInvalidTOTPSecretKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create totp secret cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// WithTOTPSecretCipher устанавливает шифр, которым секреты TOTP шифруются перед сохранением в базу.
+// Без него подключить TOTP нельзя
+func WithTOTPSecretCipher(totpCipher cipher.AEAD) ServiceOption {
+	return func(s *StaffService) {
+		s.totpCipher = totpCipher
+	}
+}
+
+// WithTOTPIssuer устанавливает название сервиса, отображаемое в приложении-аутентификаторе
+func WithTOTPIssuer(issuer string) ServiceOption {
+	return func(s *StaffService) {
+		s.totpIssuer = issuer
+	}
+}
+
+// ===== Реализация методов второго фактора =====
+
+// VerifyMFA завершение входа вторым фактором
+func (s *StaffService) VerifyMFA(ctx context.Context, req *staff.VerifyMFAIn) (*staff.VerifyMFAOut, error) {
+	if req.MfaToken == "" || (req.Code == "" && req.RecoveryCode == "") {
+		return nil, status.Error(codes.InvalidArgument, "mfa_token and code or recovery_code are required")
+	}
+
+	challenge, err := s.repo.MFAChallengeGetByToken(ctx, req.MfaToken)
+	if domainerr.IsNotFound(err) {
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa token")
+	}
+	if err != nil {
+		return nil, toStatus(err, "failed to get mfa challenge")
+	}
+
+	if challenge.ExpiresAt.Before(time.Now()) {
+		if err := s.repo.MFAChallengeDelete(ctx, challenge.ID); err != nil {
+			return nil, toStatus(err, "failed to delete expired mfa challenge")
+		}
+		return nil, status.Error(codes.Unauthenticated, "mfa token expired")
+	}
+
+	staffModel, err := s.repo.StaffGetByID(ctx, challenge.StaffID)
+	if domainerr.IsNotFound(err) {
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa token")
+	}
+	if err != nil {
+		return nil, toStatus(err, "failed to get staff")
+	}
+
+	ip := peerIP(ctx)
+	locked, err := s.isLoginLocked(ctx, staffModel.Login, ip)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check login lockout")
+	}
+	if locked {
+		return nil, invalidCredentialsError()
+	}
+
+	verified, err := s.verifySecondFactor(ctx, staffModel, req.Code, req.RecoveryCode)
+	if err != nil {
+		log.Printf("failed to verify second factor: %v", err)
+		return nil, status.Error(codes.Internal, "failed to verify code")
+	}
+	if !verified {
+		s.recordLoginFailure(ctx, staffModel.Login, ip)
+
+		attempts, err := s.repo.MFAChallengeIncrementAttempts(ctx, challenge.ID)
+		if domainerr.IsNotFound(err) {
+			// Запрос удален параллельным запросом
+			return nil, status.Error(codes.Unauthenticated, "invalid mfa token")
+		}
+		if err != nil {
+			return nil, toStatus(err, "failed to update mfa challenge")
+		}
+		if attempts >= maxMFAAttempts {
+			if err := s.repo.MFAChallengeDelete(ctx, challenge.ID); err != nil {
+				return nil, toStatus(err, "failed to delete mfa challenge")
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid code")
+	}
+
+	// Запрос MFA расходуется только вместе с созданием сессии
+	var session *model.Session
+	err = s.runInTx(ctx, "failed to create session", func(repo DbRepo) error {
+		if err := repo.MFAChallengeDelete(ctx, challenge.ID); err != nil {
+			return err
+		}
+		session, err = s.createSession(ctx, repo, staffModel, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.VerifyMFAOut{
+		AccessToken:            session.Token,
+		RefreshToken:           session.RefreshToken,
+		ExpiresAt:              session.ExpiresAt.Unix(),
+		RefreshExpiresAt:       session.RefreshExpiresAt.Unix(),
+		Staff:                  convertStaffToProto(staffModel),
+		PasswordChangeRequired: session.PasswordChangeRequired,
+	}, nil
+}
+
+// BeginTOTPEnrollment начало подключения TOTP
+func (s *StaffService) BeginTOTPEnrollment(ctx context.Context, _ *staff.BeginTOTPEnrollmentIn) (*staff.BeginTOTPEnrollmentOut, error) {
+	staffModel, err := s.currentStaff(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if staffModel.TOTPEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "totp is already enabled")
+	}
+
+	if s.totpCipher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "totp is not configured")
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate totp secret")
+	}
+
+	sealed, err := s.sealTOTPSecret(staffModel.ID, secret)
+	if err != nil {
+		log.Printf("failed to seal totp secret: %v", err)
+		return nil, status.Error(codes.Internal, "failed to save totp secret")
+	}
+
+	if err := s.repo.StaffSetTOTP(ctx, staffModel.ID, sealed, false); err != nil {
+		return nil, toStatus(err, "failed to save totp secret")
+	}
+
+	return &staff.BeginTOTPEnrollmentOut{
+		Secret:     secret,
+		OtpauthUri: totpURI(s.totpIssuer, staffModel.Login, secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment подтверждение подключения TOTP
+func (s *StaffService) ConfirmTOTPEnrollment(ctx context.Context, req *staff.ConfirmTOTPEnrollmentIn) (*staff.ConfirmTOTPEnrollmentOut, error) {
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	staffModel, err := s.currentStaff(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if staffModel.TOTPEnabled || staffModel.TOTPSecret == "" {
+		return nil, status.Error(codes.FailedPrecondition, "totp enrollment is not started")
+	}
+
+	secret, legacy, err := s.openTOTPSecret(staffModel)
+	if err != nil {
+		log.Printf("failed to open totp secret: %v", err)
+		return nil, status.Error(codes.Internal, "failed to verify code")
+	}
+
+	step, ok := validateTOTP(secret, req.Code, time.Now())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	// Секрет, сохраненный до шифрования, шифруется при подключении
+	sealed := staffModel.TOTPSecret
+	if legacy {
+		if sealed, err = s.sealTOTPSecret(staffModel.ID, secret); err != nil {
+			log.Printf("failed to seal totp secret: %v", err)
+			return nil, status.Error(codes.Internal, "failed to enable totp")
+		}
+	}
+
+	recoveryCodes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate recovery codes")
+	}
+
+	normalized := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		normalized[i] = normalizeRecoveryCode(code)
+	}
+
+	err = s.runInTx(ctx, "failed to enable totp", func(repo DbRepo) error {
+		if err := repo.StaffSetTOTP(ctx, staffModel.ID, sealed, true); err != nil {
+			return err
+		}
+		if _, err := repo.StaffAdvanceTOTPStep(ctx, staffModel.ID, step); err != nil {
+			return err
+		}
+		if err := repo.RecoveryCodesReplace(ctx, staffModel.ID, normalized); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &staffModel.ID, model.AuditActionTOTPEnable, model.AuditTargetStaff,
+			staffModel.ID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.ConfirmTOTPEnrollmentOut{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTOTP отключение TOTP
+func (s *StaffService) DisableTOTP(ctx context.Context, req *staff.DisableTOTPIn) (*staff.DisableTOTPOut, error) {
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	staffModel, err := s.currentStaff(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !staffModel.TOTPEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "totp is not enabled")
+	}
+
+	code, recoveryCode := req.Code, ""
+	if len(req.Code) != totpDigits {
+		code, recoveryCode = "", req.Code
+	}
+
+	verified, err := s.verifySecondFactor(ctx, staffModel, code, recoveryCode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify code")
+	}
+	if !verified {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	err = s.runInTx(ctx, "failed to disable totp", func(repo DbRepo) error {
+		if err := repo.StaffSetTOTP(ctx, staffModel.ID, "", false); err != nil {
+			return err
+		}
+		if err := repo.RecoveryCodesReplace(ctx, staffModel.ID, nil); err != nil {
+			return err
+		}
+		return s.audit(ctx, repo, &staffModel.ID, model.AuditActionTOTPDisable, model.AuditTargetStaff,
+			staffModel.ID.String(), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff.DisableTOTPOut{
+		Success: true,
+	}, nil
+}
+
+// ===== Вспомогательные методы второго фактора =====
+
+// createMFAChallenge создает запрос второго фактора после успешной проверки пароля
+func (s *StaffService) createMFAChallenge(ctx context.Context, staffID uuid.UUID) (*model.MFAChallenge, error) {
+	now := time.Now()
+	challenge := &model.MFAChallenge{
+		ID:        uuid.New(),
+		StaffID:   staffID,
+		Token:     generateToken(),
+		ExpiresAt: now.Add(DefaultMFAChallengeTTL),
+		CreatedAt: now,
+	}
+
+	if err := s.repo.MFAChallengeCreate(ctx, challenge); err != nil {
+		return nil, toStatus(err, "failed to create mfa challenge")
+	}
+
+	return challenge, nil
+}
+
+// verifySecondFactor проверяет код TOTP или расходует резервный код
+func (s *StaffService) verifySecondFactor(ctx context.Context, staffModel *model.Staff, code, recoveryCode string) (bool, error) {
+	if code != "" {
+		secret, legacy, err := s.openTOTPSecret(staffModel)
+		if err != nil {
+			return false, err
+		}
+
+		step, ok := validateTOTP(secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+		// Код каждого шага принимается только один раз
+		advanced, err := s.repo.StaffAdvanceTOTPStep(ctx, staffModel.ID, step)
+		if err != nil || !advanced {
+			return advanced, err
+		}
+
+		if legacy {
+			s.resealTOTPSecret(ctx, staffModel, secret)
+		}
+		return true, nil
+	}
+
+	return s.repo.RecoveryCodeUse(ctx, staffModel.ID, normalizeRecoveryCode(recoveryCode))
+}
+
+// sealTOTPSecret шифрует секрет TOTP. ID сотрудника используется как дополнительные данные,
+// чтобы зашифрованный секрет нельзя было перенести другому сотруднику
+func (s *StaffService) sealTOTPSecret(staffID uuid.UUID, secret string) (string, error) {
+	if s.totpCipher == nil {
+		return "", errTOTPCipherNotConfigured
+	}
+
+	nonce := make([]byte, s.totpCipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := s.totpCipher.Seal(nonce, nonce, []byte(secret), staffID[:])
+	return sealedTOTPSecretPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// openTOTPSecret расшифровывает секрет TOTP сотрудника. legacy означает, что секрет
+// сохранен до шифрования и хранится в базе в открытом виде
+func (s *StaffService) openTOTPSecret(staffModel *model.Staff) (secret string, legacy bool, err error) {
+	encoded, ok := strings.CutPrefix(staffModel.TOTPSecret, sealedTOTPSecretPrefix)
+	if !ok {
+		return staffModel.TOTPSecret, true, nil
+	}
+	if s.totpCipher == nil {
+		return "", false, errTOTPCipherNotConfigured
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to decode totp secret: %w", err)
+	}
+	nonceSize := s.totpCipher.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", false, errors.New("encrypted totp secret is too short")
+	}
+
+	plain, err := s.totpCipher.Open(nil, sealed[:nonceSize], sealed[nonceSize:], staffModel.ID[:])
+	if err != nil {
+		return "", false, fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	return string(plain), false, nil
+}
+
+// resealTOTPSecret шифрует секрет, сохраненный до шифрования, после успешной проверки кода.
+// Ошибка не мешает входу: секрет будет зашифрован при следующей проверке
+func (s *StaffService) resealTOTPSecret(ctx context.Context, staffModel *model.Staff, secret string) {
+	if s.totpCipher == nil {
+		return
+	}
+
+	sealed, err := s.sealTOTPSecret(staffModel.ID, secret)
+	if err != nil {
+		log.Printf("failed to seal totp secret: %v", err)
+		return
+	}
+	if err := s.repo.StaffReplaceTOTPSecret(ctx, staffModel.ID, staffModel.TOTPSecret, sealed); err != nil {
+		log.Printf("failed to replace totp secret: %v", err)
+	}
+}
+
+// generateTOTPSecret генерирует случайный секрет TOTP в base32
+func generateTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret), nil
+}
+
+// totpURI формирует otpauth URI для приложения-аутентификатора
+func totpURI(issuer, login, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + login,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// validateTOTP проверяет код с учетом допустимого рассинхрона часов и возвращает его шаг
+func validateTOTP(secret, code string, now time.Time) (int64, bool) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// totpCode вычисляет код HOTP (RFC 4226) для шага TOTP
+func totpCode(key []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// generateRecoveryCodes генерирует резервные коды вида abcd-efgh
+func generateRecoveryCodes() ([]string, error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	recoveryCodes := make([]string, recoveryCodeCount)
+	for i := range recoveryCodes {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(encoding.EncodeToString(raw))
+		recoveryCodes[i] = code[:4] + "-" + code[4:]
+	}
+
+	return recoveryCodes, nil
+}
+
+// normalizeRecoveryCode приводит резервный код к виду, в котором хранится его хеш
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.ReplaceAll(code, "-", "")
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// rfc6238Secret ключ из RFC 6238 Appendix B для SHA1
+const rfc6238Secret = "12345678901234567890"
+
+func TestTOTPCodeRFC6238Vectors(t *testing.T) {
+	// Коды Appendix B восьмизначные, сервис использует последние шесть цифр
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, tt := range tests {
+		if got := totpCode([]byte(rfc6238Secret), tt.unix/totpPeriod); got != tt.code {
+			t.Errorf("totpCode(T=%d) = %s, want %s", tt.unix, got, tt.code)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte(rfc6238Secret))
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		step   int64
+		valid  bool
+	}{
+		{name: "current step", secret: secret, code: "050471", step: current, valid: true},
+		{name: "lowercase secret", secret: strings.ToLower(secret), code: "050471", step: current, valid: true},
+		{name: "previous step within skew", secret: secret, code: totpCode([]byte(rfc6238Secret), current-1), step: current - 1, valid: true},
+		{name: "next step within skew", secret: secret, code: totpCode([]byte(rfc6238Secret), current+1), step: current + 1, valid: true},
+		{name: "step outside skew", secret: secret, code: totpCode([]byte(rfc6238Secret), current-totpSkew-1)},
+		{name: "step outside skew ahead", secret: secret, code: totpCode([]byte(rfc6238Secret), current+totpSkew+1)},
+		{name: "wrong code", secret: secret, code: "000000"},
+		{name: "short code", secret: secret, code: "05047"},
+		{name: "eight digit code", secret: secret, code: "14050471"},
+		{name: "malformed secret", secret: "not base32!", code: "050471"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := validateTOTP(tt.secret, tt.code, now)
+			if ok != tt.valid {
+				t.Fatalf("validateTOTP() ok = %v, want %v", ok, tt.valid)
+			}
+			if ok && step != tt.step {
+				t.Errorf("validateTOTP() step = %d, want %d", step, tt.step)
+			}
+		})
+	}
+}
+
+// secondFactorRepo хранит шаг TOTP и резервные коды в памяти с той же семантикой однократного использования, что и база
+type secondFactorRepo struct {
+	DbRepo
+
+	lastStep      int64
+	recoveryCodes map[string]bool // код -> использован
+	secret        string
+}
+
+func (r *secondFactorRepo) StaffAdvanceTOTPStep(_ context.Context, _ uuid.UUID, step int64) (bool, error) {
+	if step <= r.lastStep {
+		return false, nil
+	}
+	r.lastStep = step
+	return true, nil
+}
+
+func (r *secondFactorRepo) RecoveryCodeUse(_ context.Context, _ uuid.UUID, code string) (bool, error) {
+	used, ok := r.recoveryCodes[code]
+	if !ok || used {
+		return false, nil
+	}
+	r.recoveryCodes[code] = true
+	return true, nil
+}
+
+func (r *secondFactorRepo) StaffReplaceTOTPSecret(_ context.Context, _ uuid.UUID, oldSecret, newSecret string) error {
+	if r.secret == oldSecret {
+		r.secret = newSecret
+	}
+	return nil
+}
+
+func newTestTOTPService(t *testing.T, repo DbRepo) *StaffService {
+	t.Helper()
+
+	totpCipher, err := NewTOTPSecretCipher(make([]byte, totpSecretKeySize))
+	if err != nil {
+		t.Fatalf("NewTOTPSecretCipher() error = %v", err)
+	}
+	return New(repo, WithTOTPSecretCipher(totpCipher))
+}
+
+func TestVerifySecondFactorTOTPCodeIsSingleUse(t *testing.T) {
+	repo := &secondFactorRepo{}
+	s := newTestTOTPService(t, repo)
+	staffModel := &model.Staff{ID: uuid.New()}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		t.Fatalf("generateTOTPSecret() error = %v", err)
+	}
+	staffModel.TOTPSecret, err = s.sealTOTPSecret(staffModel.ID, secret)
+	if err != nil {
+		t.Fatalf("sealTOTPSecret() error = %v", err)
+	}
+
+	key, _ := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	code := totpCode(key, time.Now().Unix()/totpPeriod)
+
+	if ok, err := s.verifySecondFactor(context.Background(), staffModel, code, ""); err != nil || !ok {
+		t.Fatalf("first verifySecondFactor() = %v, %v, want true", ok, err)
+	}
+	if ok, err := s.verifySecondFactor(context.Background(), staffModel, code, ""); err != nil || ok {
+		t.Fatalf("repeated verifySecondFactor() = %v, %v, want false", ok, err)
+	}
+}
+
+func TestVerifySecondFactorRecoveryCodeIsSingleUse(t *testing.T) {
+	recoveryCodes, err := generateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("generateRecoveryCodes() error = %v", err)
+	}
+
+	repo := &secondFactorRepo{recoveryCodes: map[string]bool{}}
+	for _, code := range recoveryCodes {
+		repo.recoveryCodes[normalizeRecoveryCode(code)] = false
+	}
+	s := newTestTOTPService(t, repo)
+	staffModel := &model.Staff{ID: uuid.New()}
+
+	// Код принимается в любом регистре, с дефисом и без
+	entered := " " + strings.ToUpper(strings.ReplaceAll(recoveryCodes[0], "-", "")) + " "
+	if ok, err := s.verifySecondFactor(context.Background(), staffModel, "", entered); err != nil || !ok {
+		t.Fatalf("first verifySecondFactor() = %v, %v, want true", ok, err)
+	}
+	if ok, err := s.verifySecondFactor(context.Background(), staffModel, "", recoveryCodes[0]); err != nil || ok {
+		t.Fatalf("repeated verifySecondFactor() = %v, %v, want false", ok, err)
+	}
+	if ok, err := s.verifySecondFactor(context.Background(), staffModel, "", recoveryCodes[1]); err != nil || !ok {
+		t.Fatalf("verifySecondFactor() with another code = %v, %v, want true", ok, err)
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	recoveryCodes, err := generateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("generateRecoveryCodes() error = %v", err)
+	}
+	if len(recoveryCodes) != recoveryCodeCount {
+		t.Fatalf("generateRecoveryCodes() returned %d codes, want %d", len(recoveryCodes), recoveryCodeCount)
+	}
+
+	seen := make(map[string]bool, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		if len(code) != 9 || code[4] != '-' {
+			t.Errorf("recovery code %q does not match xxxx-xxxx", code)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q is duplicated", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestTOTPSecretSealing(t *testing.T) {
+	repo := &secondFactorRepo{}
+	s := newTestTOTPService(t, repo)
+	staffModel := &model.Staff{ID: uuid.New()}
+
+	sealed, err := s.sealTOTPSecret(staffModel.ID, "JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatalf("sealTOTPSecret() error = %v", err)
+	}
+	if strings.Contains(sealed, "JBSWY3DPEHPK3PXP") {
+		t.Fatalf("sealed secret contains the plaintext: %s", sealed)
+	}
+
+	staffModel.TOTPSecret = sealed
+	secret, legacy, err := s.openTOTPSecret(staffModel)
+	if err != nil || legacy || secret != "JBSWY3DPEHPK3PXP" {
+		t.Fatalf("openTOTPSecret() = %q, %v, %v", secret, legacy, err)
+	}
+
+	// Секрет другого сотрудника не расшифровывается
+	other := &model.Staff{ID: uuid.New(), TOTPSecret: sealed}
+	if _, _, err := s.openTOTPSecret(other); err == nil {
+		t.Fatal("openTOTPSecret() for another staff succeeded")
+	}
+
+	// Секрет, сохраненный до шифрования, читается как есть и шифруется после успешной проверки
+	repo.secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	staffModel.TOTPSecret = repo.secret
+	secret, legacy, err = s.openTOTPSecret(staffModel)
+	if err != nil || !legacy || secret != repo.secret {
+		t.Fatalf("openTOTPSecret() for legacy secret = %q, %v, %v", secret, legacy, err)
+	}
+
+	code := totpCode([]byte(rfc6238Secret), time.Now().Unix()/totpPeriod)
+	if ok, err := s.verifySecondFactor(context.Background(), staffModel, code, ""); err != nil || !ok {
+		t.Fatalf("verifySecondFactor() with legacy secret = %v, %v, want true", ok, err)
+	}
+	if !strings.HasPrefix(repo.secret, sealedTOTPSecretPrefix) {
+		t.Fatalf("legacy secret was not sealed: %s", repo.secret)
+	}
+}
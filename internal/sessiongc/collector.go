@@ -0,0 +1,168 @@
+package sessiongc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/s21platform/staff-service/internal/model"
+)
+
+// advisoryLockKey ключ advisory блокировки Postgres, которую держит выполняющая очистку реплика
+const advisoryLockKey int64 = 0x73746166665f6763 // "staff_gc"
+
+var (
+	// ErrInvalidInterval возвращается, если период очистки не положителен
+	ErrInvalidInterval = errors.New("gc interval must be positive")
+	// ErrInvalidBatchSize возвращается, если размер пакета не положителен
+	ErrInvalidBatchSize = errors.New("gc batch size must be positive")
+)
+
+// Store определяет хранилище сессий и счетчиков неудачных попыток входа
+type Store interface {
+	TryAdvisoryLock(ctx context.Context, key int64) (func(), error)
+	SessionDeleteExpired(ctx context.Context, expiredBefore, idleBefore, authenticatedBefore time.Time, limit int) (int, error)
+	LoginLockoutDeleteExpired(ctx context.Context, lastFailureBefore, lockedBefore time.Time, limit int) (int, error)
+}
+
+// Metrics определяет получателя метрик очистки
+type Metrics interface {
+	Count(name string, value int64)
+	Timing(name string, duration time.Duration)
+}
+
+// Collector периодически удаляет истекшие сессии и устаревшие счетчики неудачных попыток входа
+// пакетами ограниченного размера.
+// Из нескольких реплик очистку в каждый момент выполняет одна, захватившая advisory блокировку
+type Collector struct {
+	store       Store
+	metrics     Metrics
+	timeouts    model.SessionTimeouts
+	activityLag time.Duration
+	// failureWindow окно учета неудачных попыток входа
+	failureWindow time.Duration
+	interval      time.Duration
+	batchSize     int
+}
+
+// New создает Collector, выполняющий очистку раз в interval пакетами по batchSize сессий.
+// activityLag - насколько отметка активности в базе может отставать от фактической,
+// пока она накапливается в памяти реплик; на это время откладывается удаление по бездействию.
+// failureWindow - окно учета неудачных попыток входа, после которого счетчик без блокировки удаляется
+func New(store Store, metrics Metrics, timeouts model.SessionTimeouts, activityLag, failureWindow, interval time.Duration, batchSize int) (*Collector, error) {
+	if interval <= 0 {
+		return nil, ErrInvalidInterval
+	}
+	if batchSize <= 0 {
+		return nil, ErrInvalidBatchSize
+	}
+
+	return &Collector{
+		store:         store,
+		metrics:       metrics,
+		timeouts:      timeouts,
+		activityLag:   activityLag,
+		failureWindow: failureWindow,
+		interval:      interval,
+		batchSize:     batchSize,
+	}, nil
+}
+
+// Collect удаляет истекшие сессии и устаревшие счетчики неудачных попыток входа,
+// если блокировку не держит другая реплика. Возвращает количество удаленных сессий и счетчиков
+func (c *Collector) Collect(ctx context.Context) (int, int, error) {
+	unlock, err := c.store.TryAdvisoryLock(ctx, advisoryLockKey)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to acquire gc lock: %w", err)
+	}
+	if unlock == nil {
+		// Очистку выполняет другая реплика
+		return 0, 0, nil
+	}
+	defer unlock()
+
+	started := time.Now()
+	// Сессии, не использовавшиеся с этого момента, истекли по бездействию
+	var idleBefore time.Time
+	if c.timeouts.Idle > 0 {
+		idleBefore = started.Add(-(c.timeouts.Idle + c.activityLag))
+	}
+	// Сессии семейства, вошедшего раньше этого момента, превысили максимальное время жизни
+	var authenticatedBefore time.Time
+	if c.timeouts.Absolute > 0 {
+		authenticatedBefore = started.Add(-c.timeouts.Absolute)
+	}
+
+	sessions, err := c.deleteBatches(ctx, func(limit int) (int, error) {
+		return c.store.SessionDeleteExpired(ctx, started, idleBefore, authenticatedBefore, limit)
+	})
+	if err != nil {
+		c.report(sessions, 0, started)
+		return sessions, 0, err
+	}
+
+	// Неудачи старше окна не учитываются, поэтому счетчик без действующей блокировки можно удалить
+	lockouts, err := c.deleteBatches(ctx, func(limit int) (int, error) {
+		return c.store.LoginLockoutDeleteExpired(ctx, started.Add(-c.failureWindow), started, limit)
+	})
+	c.report(sessions, lockouts, started)
+	return sessions, lockouts, err
+}
+
+// deleteBatches повторяет удаление пакетами по batchSize, пока очередной пакет не окажется неполным.
+// Возвращает общее количество удаленных записей
+func (c *Collector) deleteBatches(ctx context.Context, deleteBatch func(limit int) (int, error)) (int, error) {
+	total := 0
+	for {
+		deleted, err := deleteBatch(c.batchSize)
+		total += deleted
+		if err != nil {
+			return total, err
+		}
+		if deleted < c.batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+// Run выполняет очистку сразу и затем раз в интервал до отмены контекста,
+// чтобы перезапуск сервиса не откладывал очистку на целый интервал
+func (c *Collector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.collect(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect выполняет Collect и записывает результат в лог
+func (c *Collector) collect(ctx context.Context) {
+	sessions, lockouts, err := c.Collect(ctx)
+	if err != nil {
+		log.Printf("failed to collect expired sessions: %v", err)
+		return
+	}
+	if sessions > 0 || lockouts > 0 {
+		log.Printf("deleted %d expired sessions and %d stale login failure counters", sessions, lockouts)
+	}
+}
+
+// report отправляет метрики выполненной очистки
+func (c *Collector) report(sessions, lockouts int, started time.Time) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.Count("session_gc.runs", 1)
+	c.metrics.Count("session_gc.deleted", int64(sessions))
+	c.metrics.Count("session_gc.lockouts_deleted", int64(lockouts))
+	c.metrics.Timing("session_gc.duration", time.Since(started))
+}
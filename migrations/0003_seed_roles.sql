@@ -0,0 +1,14 @@
+-- +goose Up
+INSERT INTO roles (id, name)
+VALUES (1, 'owner'),
+       (2, 'admin'),
+       (3, 'staff'),
+       (4, 'viewer')
+ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name;
+
+-- Сдвигаем последовательность, чтобы новые роли не конфликтовали с системными
+SELECT setval('roles_id_seq', GREATEST((SELECT MAX(id) FROM roles), 4));
+
+-- +goose Down
+DELETE FROM roles
+WHERE id IN (1, 2, 3, 4);
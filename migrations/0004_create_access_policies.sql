@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS access_policies
+(
+    method TEXT PRIMARY KEY, -- полное имя gRPC метода, например /staff.StaffService/Get
+    roles INTEGER[] NOT NULL DEFAULT '{}',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO access_policies (method, roles, permissions)
+VALUES ('/staff.StaffService/Create', '{1}', '{staff:write}'),
+       ('/staff.StaffService/Update', '{1,2}', '{staff:write}'),
+       ('/staff.StaffService/Delete', '{1}', '{staff:delete}'),
+       ('/staff.StaffService/List', '{1,2,3,4}', '{staff:read}'),
+       ('/staff.StaffService/Get', '{1,2,3,4}', '{staff:read}'),
+       ('/staff.StaffService/ListRoles', '{1,2,3,4}', '{roles:read}'),
+       ('/staff.StaffService/GetRole', '{1,2,3,4}', '{roles:read}'),
+       ('/staff.StaffService/CreateRole', '{1}', '{roles:write}'),
+       ('/staff.StaffService/UpdateRole', '{1}', '{roles:write}'),
+       ('/staff.StaffService/DeleteRole', '{1}', '{roles:write}')
+ON CONFLICT (method) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS access_policies;
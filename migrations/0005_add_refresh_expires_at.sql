@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE sessions
+    ADD COLUMN refresh_expires_at TIMESTAMP WITH TIME ZONE;
+
+-- Для существующих сессий refresh токен действует 7 дней с момента создания
+UPDATE sessions
+SET refresh_expires_at = created_at + INTERVAL '7 days'
+WHERE refresh_expires_at IS NULL;
+
+ALTER TABLE sessions
+    ALTER COLUMN refresh_expires_at SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE sessions
+    DROP COLUMN refresh_expires_at;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Сессии, полученные цепочкой обновлений refresh токена, объединяются в семейство
+ALTER TABLE sessions
+    ADD COLUMN family_id UUID,
+    ADD COLUMN rotated_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE sessions
+SET family_id = id
+WHERE family_id IS NULL;
+
+ALTER TABLE sessions
+    ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_sessions_family_id ON sessions (family_id);
+
+CREATE TABLE IF NOT EXISTS security_events
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    staff_id UUID REFERENCES staff(id) ON DELETE SET NULL,
+    event_type TEXT NOT NULL, -- refresh_token_reuse
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_events_staff_id ON security_events (staff_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS security_events;
+
+ALTER TABLE sessions
+    DROP COLUMN rotated_at,
+    DROP COLUMN family_id;
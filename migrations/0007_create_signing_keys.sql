@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS signing_keys
+(
+    id TEXT PRIMARY KEY, -- kid, публикуется в заголовке JWT и в JWKS
+    algorithm TEXT NOT NULL, -- EdDSA, RS256
+    private_key BYTEA NOT NULL, -- PKCS #8, DER, зашифрован AES-256-GCM (см. 0019)
+    public_key BYTEA NOT NULL, -- PKIX, DER
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_signing_keys_created_at ON signing_keys (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS signing_keys;
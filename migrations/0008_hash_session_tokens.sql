@@ -0,0 +1,21 @@
+-- +goose Up
+-- Токены сессий хранятся в виде SHA-256 хешей, сами токены известны только клиенту
+UPDATE sessions
+SET token         = encode(digest(token, 'sha256'), 'hex'),
+    refresh_token = encode(digest(refresh_token, 'sha256'), 'hex');
+
+ALTER TABLE sessions
+    RENAME COLUMN token TO token_hash;
+
+ALTER TABLE sessions
+    RENAME COLUMN refresh_token TO refresh_token_hash;
+
+-- +goose Down
+-- Исходные токены по хешам не восстановить, поэтому все сессии завершаются
+DELETE FROM sessions;
+
+ALTER TABLE sessions
+    RENAME COLUMN token_hash TO token;
+
+ALTER TABLE sessions
+    RENAME COLUMN refresh_token_hash TO refresh_token;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS login_lockouts
+(
+    scope TEXT NOT NULL, -- login, ip
+    subject TEXT NOT NULL, -- логин или адрес клиента
+    failures INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    last_failure_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (scope, subject)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS login_lockouts;
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE staff
+    ADD COLUMN must_change_password BOOLEAN NOT NULL DEFAULT FALSE, -- пароль выдан администратором и должен быть сменен при входе
+    ADD COLUMN password_changed_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE staff SET password_changed_at = COALESCE(updated_at, created_at, CURRENT_TIMESTAMP);
+
+ALTER TABLE staff
+    ALTER COLUMN password_changed_at SET NOT NULL,
+    ALTER COLUMN password_changed_at SET DEFAULT CURRENT_TIMESTAMP;
+
+ALTER TABLE sessions
+    ADD COLUMN password_change_required BOOLEAN NOT NULL DEFAULT FALSE; -- сессия ограничена сменой пароля и выходом
+
+-- +goose Down
+ALTER TABLE sessions
+    DROP COLUMN password_change_required;
+
+ALTER TABLE staff
+    DROP COLUMN password_changed_at,
+    DROP COLUMN must_change_password;
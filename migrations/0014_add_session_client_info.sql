@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE sessions
+    ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN ip TEXT NOT NULL DEFAULT ''; -- адрес клиента при входе
+
+CREATE INDEX IF NOT EXISTS idx_sessions_staff_id ON sessions (staff_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_sessions_staff_id;
+
+ALTER TABLE sessions
+    DROP COLUMN ip,
+    DROP COLUMN user_agent;
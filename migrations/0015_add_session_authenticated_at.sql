@@ -0,0 +1,19 @@
+-- +goose Up
+-- Момент входа, общий для всех сессий семейства; от него отсчитывается максимальное время жизни
+ALTER TABLE sessions
+    ADD COLUMN authenticated_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE sessions s
+SET authenticated_at = f.authenticated_at
+FROM (SELECT family_id, MIN(COALESCE(created_at, CURRENT_TIMESTAMP)) AS authenticated_at
+      FROM sessions
+      GROUP BY family_id) f
+WHERE s.family_id = f.family_id;
+
+ALTER TABLE sessions
+    ALTER COLUMN authenticated_at SET NOT NULL,
+    ALTER COLUMN authenticated_at SET DEFAULT CURRENT_TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE sessions
+    DROP COLUMN authenticated_at;
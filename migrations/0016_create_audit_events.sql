@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id BIGSERIAL PRIMARY KEY,
+    actor_id UUID, -- сотрудник, выполнивший действие; без внешнего ключа, чтобы записи переживали удаление сотрудника
+    action TEXT NOT NULL, -- staff.create, auth.login, role.delete и т.д.
+    target_type TEXT NOT NULL, -- staff, role, access_policy, session, login_lockout
+    target_id TEXT NOT NULL DEFAULT '',
+    changes JSONB NOT NULL DEFAULT '{}', -- {"поле": {"before": ..., "after": ...}}
+    ip TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events (actor_id, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target_id ON audit_events (target_id, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON audit_events (action, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at);
+
+-- Журнал только дополняется: изменение и удаление записей запрещены
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW
+EXECUTE FUNCTION audit_events_append_only();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+DROP FUNCTION IF EXISTS audit_events_append_only();
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE staff
+    ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE, -- отключенный сотрудник не может войти в систему
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE; -- время удаления, запись хранится до окончательного удаления
+
+CREATE INDEX IF NOT EXISTS idx_staff_deleted_at ON staff (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_staff_deleted_at;
+
+ALTER TABLE staff
+    DROP COLUMN deleted_at,
+    DROP COLUMN is_active;
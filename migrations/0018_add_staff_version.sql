@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE staff
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1; -- увеличивается при каждом изменении, используется для оптимистичной блокировки
+
+-- +goose Down
+ALTER TABLE staff
+    DROP COLUMN version;
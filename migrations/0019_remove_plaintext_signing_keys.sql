@@ -0,0 +1,8 @@
+-- +goose Up
+-- Закрытые ключи подписи теперь хранятся зашифрованными. Ключи, сохраненные без шифрования,
+-- удаляются: сервис создаст новый ключ при запуске, токены старых ключей перестанут проверяться
+DELETE FROM signing_keys;
+
+-- +goose Down
+-- Удаленные ключи не восстанавливаются
+SELECT 1;
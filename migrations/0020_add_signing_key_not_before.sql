@@ -0,0 +1,12 @@
+-- +goose Up
+-- Ключ подписи создается заранее для слота ротации и начинает подписывать токены с not_before.
+-- Уникальный слот не дает нескольким репликам одновременно создать разные ключи на один период
+ALTER TABLE signing_keys ADD COLUMN IF NOT EXISTS not_before TIMESTAMP WITH TIME ZONE;
+UPDATE signing_keys SET not_before = created_at WHERE not_before IS NULL;
+ALTER TABLE signing_keys ALTER COLUMN not_before SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_signing_keys_algorithm_not_before ON signing_keys (algorithm, not_before);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_signing_keys_algorithm_not_before;
+ALTER TABLE signing_keys DROP COLUMN IF EXISTS not_before;
@@ -0,0 +1,46 @@
+-- +goose Up
+-- Политики доступа хранятся только в таблице: метод без политики доступен только владельцу.
+-- Переносим сюда политики, которые раньше действовали по умолчанию из кода сервиса.
+-- Уже измененные политики не перезаписываются
+INSERT INTO access_policies (method, roles, permissions)
+VALUES ('/staff.StaffService/Create', '{1}', '{staff:write}'),
+       ('/staff.StaffService/Update', '{1,2}', '{staff:write}'),
+       ('/staff.StaffService/Delete', '{1}', '{staff:delete}'),
+       ('/staff.StaffService/List', '{1,2,3,4}', '{staff:read}'),
+       ('/staff.StaffService/Get', '{1,2,3,4}', '{staff:read}'),
+       ('/staff.StaffService/Deactivate', '{1,2}', '{staff:write}'),
+       ('/staff.StaffService/Reactivate', '{1,2}', '{staff:write}'),
+       ('/staff.StaffService/Purge', '{1}', '{}'),
+       ('/staff.StaffService/Logout', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/CheckAuth', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/ChangePassword', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/ListRoles', '{1,2,3,4}', '{roles:read}'),
+       ('/staff.StaffService/GetRole', '{1,2,3,4}', '{roles:read}'),
+       ('/staff.StaffService/CreateRole', '{1}', '{roles:write}'),
+       ('/staff.StaffService/UpdateRole', '{1}', '{roles:write}'),
+       ('/staff.StaffService/DeleteRole', '{1}', '{roles:write}'),
+       ('/staff.StaffService/BeginTOTPEnrollment', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/ConfirmTOTPEnrollment', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/DisableTOTP', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/ListMySessions', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/RevokeSession', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/RevokeAllOtherSessions', '{1,2,3,4}', '{}'),
+       ('/staff.StaffService/ListStaffSessions', '{1,2}', '{}'),
+       ('/staff.StaffService/RevokeStaffSessions', '{1,2}', '{}'),
+       ('/staff.StaffService/RequestPasswordReset', '{1,2}', '{}'),
+       ('/staff.StaffService/ListLoginLockouts', '{1,2}', '{}'),
+       ('/staff.StaffService/ClearLoginLockout', '{1,2}', '{}'),
+       ('/staff.StaffService/ListAuditEvents', '{1,2}', '{audit:read}')
+ON CONFLICT (method) DO NOTHING;
+
+-- +goose Down
+-- Политики из 0004 остаются, остальные методы снова получают доступ по умолчанию из кода
+DELETE FROM access_policies
+WHERE method IN ('/staff.StaffService/Deactivate', '/staff.StaffService/Reactivate', '/staff.StaffService/Purge',
+                 '/staff.StaffService/Logout', '/staff.StaffService/CheckAuth', '/staff.StaffService/ChangePassword',
+                 '/staff.StaffService/BeginTOTPEnrollment', '/staff.StaffService/ConfirmTOTPEnrollment',
+                 '/staff.StaffService/DisableTOTP', '/staff.StaffService/ListMySessions',
+                 '/staff.StaffService/RevokeSession', '/staff.StaffService/RevokeAllOtherSessions',
+                 '/staff.StaffService/ListStaffSessions', '/staff.StaffService/RevokeStaffSessions',
+                 '/staff.StaffService/RequestPasswordReset', '/staff.StaffService/ListLoginLockouts',
+                 '/staff.StaffService/ClearLoginLockout', '/staff.StaffService/ListAuditEvents');
@@ -235,10 +235,12 @@ type UpdateIn struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Login       *string      `protobuf:"bytes,2,opt,name=login,proto3,oneof" json:"login,omitempty"`
-	RoleId      *int32       `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`
-	Permissions *Permissions `protobuf:"bytes,4,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	Id                 string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login              *string      `protobuf:"bytes,2,opt,name=login,proto3,oneof" json:"login,omitempty"`
+	RoleId             *int32       `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`
+	Permissions        *Permissions `protobuf:"bytes,4,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	MustChangePassword *bool        `protobuf:"varint,5,opt,name=must_change_password,json=mustChangePassword,proto3,oneof" json:"must_change_password,omitempty"` // потребовать смену пароля при следующем входе
+	Version            *int64       `protobuf:"varint,6,opt,name=version,proto3,oneof" json:"version,omitempty"`                                                   // ожидаемая версия записи, при несовпадении возвращается ABORTED
 }
 
 func (x *UpdateIn) Reset() {
@@ -299,6 +301,20 @@ func (x *UpdateIn) GetPermissions() *Permissions {
 	return nil
 }
 
+func (x *UpdateIn) GetMustChangePassword() bool {
+	if x != nil && x.MustChangePassword != nil {
+		return *x.MustChangePassword
+	}
+	return false
+}
+
+func (x *UpdateIn) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 // Ответ с обновленной информацией о сотруднике
 type UpdateOut struct {
 	state         protoimpl.MessageState
@@ -437,32 +453,29 @@ func (x *DeleteOut) GetSuccess() bool {
 	return false
 }
 
-// Запрос на получение списка сотрудников
-type ListIn struct {
+// Запрос на отключение сотрудника
+type DeactivateIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page       int32   `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize   int32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SearchTerm *string `protobuf:"bytes,3,opt,name=search_term,json=searchTerm,proto3,oneof" json:"search_term,omitempty"` // поиск по логину
-	RoleId     *int32  `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`            // фильтр по роли
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListIn) Reset() {
-	*x = ListIn{}
+func (x *DeactivateIn) Reset() {
+	*x = DeactivateIn{}
 	mi := &file_api_staff_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIn) String() string {
+func (x *DeactivateIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIn) ProtoMessage() {}
+func (*DeactivateIn) ProtoMessage() {}
 
-func (x *ListIn) ProtoReflect() protoreflect.Message {
+func (x *DeactivateIn) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -474,64 +487,41 @@ func (x *ListIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIn.ProtoReflect.Descriptor instead.
-func (*ListIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateIn.ProtoReflect.Descriptor instead.
+func (*DeactivateIn) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListIn) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListIn) GetPageSize() int32 {
+func (x *DeactivateIn) GetId() string {
 	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListIn) GetSearchTerm() string {
-	if x != nil && x.SearchTerm != nil {
-		return *x.SearchTerm
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListIn) GetRoleId() int32 {
-	if x != nil && x.RoleId != nil {
-		return *x.RoleId
-	}
-	return 0
-}
-
-// Ответ со списком сотрудников
-type ListOut struct {
+// Ответ на отключение сотрудника
+type DeactivateOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Staff      []*Staff `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
-	TotalCount int32    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	PageCount  int32    `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Staff *Staff `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
 }
 
-func (x *ListOut) Reset() {
-	*x = ListOut{}
+func (x *DeactivateOut) Reset() {
+	*x = DeactivateOut{}
 	mi := &file_api_staff_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOut) String() string {
+func (x *DeactivateOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOut) ProtoMessage() {}
+func (*DeactivateOut) ProtoMessage() {}
 
-func (x *ListOut) ProtoReflect() protoreflect.Message {
+func (x *DeactivateOut) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,61 +533,41 @@ func (x *ListOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOut.ProtoReflect.Descriptor instead.
-func (*ListOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateOut.ProtoReflect.Descriptor instead.
+func (*DeactivateOut) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListOut) GetStaff() []*Staff {
+func (x *DeactivateOut) GetStaff() *Staff {
 	if x != nil {
 		return x.Staff
 	}
 	return nil
 }
 
-func (x *ListOut) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListOut) GetPageCount() int32 {
-	if x != nil {
-		return x.PageCount
-	}
-	return 0
-}
-
-// Структура данных сотрудника
-type Staff struct {
+// Запрос на восстановление сотрудника
+type ReactivateIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Login       string       `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
-	RoleId      int32        `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	RoleName    string       `protobuf:"bytes,4,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	Permissions *Permissions `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	CreatedAt   int64        `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   int64        `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Staff) Reset() {
-	*x = Staff{}
+func (x *ReactivateIn) Reset() {
+	*x = ReactivateIn{}
 	mi := &file_api_staff_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Staff) String() string {
+func (x *ReactivateIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Staff) ProtoMessage() {}
+func (*ReactivateIn) ProtoMessage() {}
 
-func (x *Staff) ProtoReflect() protoreflect.Message {
+func (x *ReactivateIn) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,84 +579,41 @@ func (x *Staff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Staff.ProtoReflect.Descriptor instead.
-func (*Staff) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactivateIn.ProtoReflect.Descriptor instead.
+func (*ReactivateIn) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Staff) GetId() string {
+func (x *ReactivateIn) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Staff) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
-}
-
-func (x *Staff) GetRoleId() int32 {
-	if x != nil {
-		return x.RoleId
-	}
-	return 0
-}
-
-func (x *Staff) GetRoleName() string {
-	if x != nil {
-		return x.RoleName
-	}
-	return ""
-}
-
-func (x *Staff) GetPermissions() *Permissions {
-	if x != nil {
-		return x.Permissions
-	}
-	return nil
-}
-
-func (x *Staff) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Staff) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-// Запрос на авторизацию
-type LoginIn struct {
+// Ответ на восстановление сотрудника
+type ReactivateOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Staff *Staff `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
 }
 
-func (x *LoginIn) Reset() {
-	*x = LoginIn{}
+func (x *ReactivateOut) Reset() {
+	*x = ReactivateOut{}
 	mi := &file_api_staff_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginIn) String() string {
+func (x *ReactivateOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginIn) ProtoMessage() {}
+func (*ReactivateOut) ProtoMessage() {}
 
-func (x *LoginIn) ProtoReflect() protoreflect.Message {
+func (x *ReactivateOut) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -698,51 +625,41 @@ func (x *LoginIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginIn.ProtoReflect.Descriptor instead.
-func (*LoginIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactivateOut.ProtoReflect.Descriptor instead.
+func (*ReactivateOut) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LoginIn) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
-}
-
-func (x *LoginIn) GetPassword() string {
+func (x *ReactivateOut) GetStaff() *Staff {
 	if x != nil {
-		return x.Password
+		return x.Staff
 	}
-	return ""
+	return nil
 }
 
-// Ответ на успешную авторизацию
-type LoginOut struct {
+// Запрос на окончательное удаление сотрудника
+type PurgeIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt    int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время истечения токена в unix timestamp
-	Staff        *Staff `protobuf:"bytes,4,opt,name=staff,proto3" json:"staff,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *LoginOut) Reset() {
-	*x = LoginOut{}
+func (x *PurgeIn) Reset() {
+	*x = PurgeIn{}
 	mi := &file_api_staff_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginOut) String() string {
+func (x *PurgeIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginOut) ProtoMessage() {}
+func (*PurgeIn) ProtoMessage() {}
 
-func (x *LoginOut) ProtoReflect() protoreflect.Message {
+func (x *PurgeIn) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,62 +671,41 @@ func (x *LoginOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginOut.ProtoReflect.Descriptor instead.
-func (*LoginOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeIn.ProtoReflect.Descriptor instead.
+func (*PurgeIn) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LoginOut) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *LoginOut) GetRefreshToken() string {
+func (x *PurgeIn) GetId() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.Id
 	}
 	return ""
 }
 
-func (x *LoginOut) GetExpiresAt() int64 {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return 0
-}
-
-func (x *LoginOut) GetStaff() *Staff {
-	if x != nil {
-		return x.Staff
-	}
-	return nil
-}
-
-// Запрос на обновление токена
-type RefreshTokenIn struct {
+// Ответ на окончательное удаление сотрудника
+type PurgeOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *RefreshTokenIn) Reset() {
-	*x = RefreshTokenIn{}
+func (x *PurgeOut) Reset() {
+	*x = PurgeOut{}
 	mi := &file_api_staff_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenIn) String() string {
+func (x *PurgeOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenIn) ProtoMessage() {}
+func (*PurgeOut) ProtoMessage() {}
 
-func (x *RefreshTokenIn) ProtoReflect() protoreflect.Message {
+func (x *PurgeOut) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,43 +717,45 @@ func (x *RefreshTokenIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenIn.ProtoReflect.Descriptor instead.
-func (*RefreshTokenIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeOut.ProtoReflect.Descriptor instead.
+func (*PurgeOut) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RefreshTokenIn) GetRefreshToken() string {
+func (x *PurgeOut) GetSuccess() bool {
 	if x != nil {
-		return x.RefreshToken
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-// Ответ с обновленным токеном
-type RefreshTokenOut struct {
+// Запрос на получение списка сотрудников
+type ListIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt    int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время истечения токена в unix timestamp
+	Page            int32   `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SearchTerm      *string `protobuf:"bytes,3,opt,name=search_term,json=searchTerm,proto3,oneof" json:"search_term,omitempty"`           // поиск по логину
+	RoleId          *int32  `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`                      // фильтр по роли
+	IncludeInactive bool    `protobuf:"varint,5,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // включать отключенных и удаленных сотрудников
 }
 
-func (x *RefreshTokenOut) Reset() {
-	*x = RefreshTokenOut{}
+func (x *ListIn) Reset() {
+	*x = ListIn{}
 	mi := &file_api_staff_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshTokenOut) String() string {
+func (x *ListIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenOut) ProtoMessage() {}
+func (*ListIn) ProtoMessage() {}
 
-func (x *RefreshTokenOut) ProtoReflect() protoreflect.Message {
+func (x *ListIn) ProtoReflect() protoreflect.Message {
 	mi := &file_api_staff_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,56 +767,3133 @@ func (x *RefreshTokenOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenOut.ProtoReflect.Descriptor instead.
-func (*RefreshTokenOut) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListIn.ProtoReflect.Descriptor instead.
+func (*ListIn) Descriptor() ([]byte, []int) {
 	return file_api_staff_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RefreshTokenOut) GetAccessToken() string {
+func (x *ListIn) GetPage() int32 {
 	if x != nil {
-		return x.AccessToken
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *RefreshTokenOut) GetRefreshToken() string {
+func (x *ListIn) GetPageSize() int32 {
 	if x != nil {
-		return x.RefreshToken
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIn) GetSearchTerm() string {
+	if x != nil && x.SearchTerm != nil {
+		return *x.SearchTerm
 	}
 	return ""
 }
 
-func (x *RefreshTokenOut) GetExpiresAt() int64 {
-	if x != nil {
-		return x.ExpiresAt
+func (x *ListIn) GetRoleId() int32 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
 	}
 	return 0
 }
 
-// Запрос на выход из системы
-type LogoutIn struct {
+func (x *ListIn) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// Ответ со списком сотрудников
+type ListOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Staff      []*Staff `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	TotalCount int32    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageCount  int32    `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
 }
 
-func (x *LogoutIn) Reset() {
-	*x = LogoutIn{}
+func (x *ListOut) Reset() {
+	*x = ListOut{}
 	mi := &file_api_staff_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutIn) String() string {
+func (x *ListOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOut) ProtoMessage() {}
+
+func (x *ListOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOut.ProtoReflect.Descriptor instead.
+func (*ListOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOut) GetStaff() []*Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *ListOut) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListOut) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+// Структура данных сотрудника
+type Staff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login              string       `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	RoleId             int32        `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	RoleName           string       `protobuf:"bytes,4,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Permissions        *Permissions `protobuf:"bytes,5,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	CreatedAt          int64        `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          int64        `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TotpEnabled        bool         `protobuf:"varint,8,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	MustChangePassword bool         `protobuf:"varint,9,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
+	PasswordChangedAt  int64        `protobuf:"varint,10,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"` // время последней смены пароля в unix timestamp
+	IsActive           bool         `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DeletedAt          *int64       `protobuf:"varint,12,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"` // время удаления в unix timestamp
+	Version            int64        `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`                            // версия записи, увеличивается при каждом изменении
+}
+
+func (x *Staff) Reset() {
+	*x = Staff{}
+	mi := &file_api_staff_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Staff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Staff) ProtoMessage() {}
+
+func (x *Staff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Staff.ProtoReflect.Descriptor instead.
+func (*Staff) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Staff) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Staff) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *Staff) GetRoleId() int32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *Staff) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *Staff) GetPermissions() *Permissions {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Staff) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Staff) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Staff) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+func (x *Staff) GetMustChangePassword() bool {
+	if x != nil {
+		return x.MustChangePassword
+	}
+	return false
+}
+
+func (x *Staff) GetPasswordChangedAt() int64 {
+	if x != nil {
+		return x.PasswordChangedAt
+	}
+	return 0
+}
+
+func (x *Staff) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Staff) GetDeletedAt() int64 {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Staff) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Запрос на авторизацию
+type LoginIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginIn) Reset() {
+	*x = LoginIn{}
+	mi := &file_api_staff_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginIn) ProtoMessage() {}
+
+func (x *LoginIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginIn.ProtoReflect.Descriptor instead.
+func (*LoginIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LoginIn) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *LoginIn) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Ответ на успешную авторизацию.
+// Если у сотрудника подключен TOTP, токены не выдаются: вместо них возвращается
+// mfa_token, который нужно передать в VerifyMFA вместе с кодом.
+// Если password_change_required, сессия позволяет вызвать только ChangePassword и Logout
+type LoginOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken            string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken           string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt              int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время истечения токена в unix timestamp
+	Staff                  *Staff `protobuf:"bytes,4,opt,name=staff,proto3" json:"staff,omitempty"`
+	RefreshExpiresAt       int64  `protobuf:"varint,5,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"` // время истечения refresh токена в unix timestamp
+	MfaRequired            bool   `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken               string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresAt           int64  `protobuf:"varint,8,opt,name=mfa_expires_at,json=mfaExpiresAt,proto3" json:"mfa_expires_at,omitempty"`                               // время истечения mfa_token в unix timestamp
+	PasswordChangeRequired bool   `protobuf:"varint,9,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"` // пароль нужно сменить: выдан администратором или истек
+}
+
+func (x *LoginOut) Reset() {
+	*x = LoginOut{}
+	mi := &file_api_staff_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginOut) ProtoMessage() {}
+
+func (x *LoginOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginOut.ProtoReflect.Descriptor instead.
+func (*LoginOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LoginOut) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LoginOut) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginOut) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *LoginOut) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *LoginOut) GetRefreshExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return 0
+}
+
+func (x *LoginOut) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginOut) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginOut) GetMfaExpiresAt() int64 {
+	if x != nil {
+		return x.MfaExpiresAt
+	}
+	return 0
+}
+
+func (x *LoginOut) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+// Запрос на обновление токена
+type RefreshTokenIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *RefreshTokenIn) Reset() {
+	*x = RefreshTokenIn{}
+	mi := &file_api_staff_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenIn) ProtoMessage() {}
+
+func (x *RefreshTokenIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenIn.ProtoReflect.Descriptor instead.
+func (*RefreshTokenIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RefreshTokenIn) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Ответ с обновленным токеном
+type RefreshTokenOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken            string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken           string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt              int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                        // время истечения токена в unix timestamp
+	RefreshExpiresAt       int64  `protobuf:"varint,4,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"` // время истечения refresh токена в unix timestamp
+	PasswordChangeRequired bool   `protobuf:"varint,5,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+}
+
+func (x *RefreshTokenOut) Reset() {
+	*x = RefreshTokenOut{}
+	mi := &file_api_staff_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenOut) ProtoMessage() {}
+
+func (x *RefreshTokenOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenOut.ProtoReflect.Descriptor instead.
+func (*RefreshTokenOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefreshTokenOut) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenOut) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenOut) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RefreshTokenOut) GetRefreshExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return 0
+}
+
+func (x *RefreshTokenOut) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+// Запрос на выход из системы
+type LogoutIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Не используется: сессия определяется по токену из метаданных authorization
+	//
+	// Deprecated: Marked as deprecated in api/staff.proto.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *LogoutIn) Reset() {
+	*x = LogoutIn{}
+	mi := &file_api_staff_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutIn) ProtoMessage() {}
+
+func (x *LogoutIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutIn.ProtoReflect.Descriptor instead.
+func (*LogoutIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{21}
+}
+
+// Deprecated: Marked as deprecated in api/staff.proto.
+func (x *LogoutIn) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// Ответ на выход из системы
+type LogoutOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *LogoutOut) Reset() {
+	*x = LogoutOut{}
+	mi := &file_api_staff_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutOut) ProtoMessage() {}
+
+func (x *LogoutOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutOut.ProtoReflect.Descriptor instead.
+func (*LogoutOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LogoutOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос на проверку авторизации
+type CheckAuthIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Не используется: сессия определяется по токену из метаданных authorization
+	//
+	// Deprecated: Marked as deprecated in api/staff.proto.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *CheckAuthIn) Reset() {
+	*x = CheckAuthIn{}
+	mi := &file_api_staff_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAuthIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAuthIn) ProtoMessage() {}
+
+func (x *CheckAuthIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAuthIn.ProtoReflect.Descriptor instead.
+func (*CheckAuthIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{23}
+}
+
+// Deprecated: Marked as deprecated in api/staff.proto.
+func (x *CheckAuthIn) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// Ответ на проверку авторизации.
+// Сессия, ограниченная сменой пароля, не считается авторизованной
+type CheckAuthOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authorized             bool   `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Staff                  *Staff `protobuf:"bytes,2,opt,name=staff,proto3" json:"staff,omitempty"`
+	PasswordChangeRequired bool   `protobuf:"varint,3,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+}
+
+func (x *CheckAuthOut) Reset() {
+	*x = CheckAuthOut{}
+	mi := &file_api_staff_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAuthOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAuthOut) ProtoMessage() {}
+
+func (x *CheckAuthOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAuthOut.ProtoReflect.Descriptor instead.
+func (*CheckAuthOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckAuthOut) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+func (x *CheckAuthOut) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *CheckAuthOut) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+// Запрос на смену пароля
+type ChangePasswordIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPassword string `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	// Не используется: сотрудник определяется по токену из метаданных authorization
+	//
+	// Deprecated: Marked as deprecated in api/staff.proto.
+	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *ChangePasswordIn) Reset() {
+	*x = ChangePasswordIn{}
+	mi := &file_api_staff_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordIn) ProtoMessage() {}
+
+func (x *ChangePasswordIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordIn.ProtoReflect.Descriptor instead.
+func (*ChangePasswordIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChangePasswordIn) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordIn) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/staff.proto.
+func (x *ChangePasswordIn) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// Ответ на смену пароля
+type ChangePasswordOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ChangePasswordOut) Reset() {
+	*x = ChangePasswordOut{}
+	mi := &file_api_staff_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordOut) ProtoMessage() {}
+
+func (x *ChangePasswordOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordOut.ProtoReflect.Descriptor instead.
+func (*ChangePasswordOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangePasswordOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос на получение сессий текущего сотрудника
+type ListMySessionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMySessionsIn) Reset() {
+	*x = ListMySessionsIn{}
+	mi := &file_api_staff_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsIn) ProtoMessage() {}
+
+func (x *ListMySessionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsIn.ProtoReflect.Descriptor instead.
+func (*ListMySessionsIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{27}
+}
+
+// Ответ со списком сессий текущего сотрудника
+type ListMySessionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListMySessionsOut) Reset() {
+	*x = ListMySessionsOut{}
+	mi := &file_api_staff_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsOut) ProtoMessage() {}
+
+func (x *ListMySessionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsOut.ProtoReflect.Descriptor instead.
+func (*ListMySessionsOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMySessionsOut) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Запрос на завершение сессии
+type RevokeSessionIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *RevokeSessionIn) Reset() {
+	*x = RevokeSessionIn{}
+	mi := &file_api_staff_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionIn) ProtoMessage() {}
+
+func (x *RevokeSessionIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionIn.ProtoReflect.Descriptor instead.
+func (*RevokeSessionIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeSessionIn) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// Ответ на завершение сессии
+type RevokeSessionOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RevokeSessionOut) Reset() {
+	*x = RevokeSessionOut{}
+	mi := &file_api_staff_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionOut) ProtoMessage() {}
+
+func (x *RevokeSessionOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionOut.ProtoReflect.Descriptor instead.
+func (*RevokeSessionOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeSessionOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос на завершение остальных сессий
+type RevokeAllOtherSessionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeAllOtherSessionsIn) Reset() {
+	*x = RevokeAllOtherSessionsIn{}
+	mi := &file_api_staff_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsIn) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsIn.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{31}
+}
+
+// Ответ на завершение остальных сессий
+type RevokeAllOtherSessionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevokedCount int32 `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+}
+
+func (x *RevokeAllOtherSessionsOut) Reset() {
+	*x = RevokeAllOtherSessionsOut{}
+	mi := &file_api_staff_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsOut) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsOut.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevokeAllOtherSessionsOut) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// Запрос на получение сессий сотрудника
+type ListStaffSessionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaffId string `protobuf:"bytes,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+}
+
+func (x *ListStaffSessionsIn) Reset() {
+	*x = ListStaffSessionsIn{}
+	mi := &file_api_staff_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffSessionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffSessionsIn) ProtoMessage() {}
+
+func (x *ListStaffSessionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffSessionsIn.ProtoReflect.Descriptor instead.
+func (*ListStaffSessionsIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListStaffSessionsIn) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+// Ответ со списком сессий сотрудника
+type ListStaffSessionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListStaffSessionsOut) Reset() {
+	*x = ListStaffSessionsOut{}
+	mi := &file_api_staff_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffSessionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffSessionsOut) ProtoMessage() {}
+
+func (x *ListStaffSessionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffSessionsOut.ProtoReflect.Descriptor instead.
+func (*ListStaffSessionsOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListStaffSessionsOut) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Запрос на завершение всех сессий сотрудника
+type RevokeStaffSessionsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaffId string `protobuf:"bytes,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+}
+
+func (x *RevokeStaffSessionsIn) Reset() {
+	*x = RevokeStaffSessionsIn{}
+	mi := &file_api_staff_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeStaffSessionsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeStaffSessionsIn) ProtoMessage() {}
+
+func (x *RevokeStaffSessionsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeStaffSessionsIn.ProtoReflect.Descriptor instead.
+func (*RevokeStaffSessionsIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RevokeStaffSessionsIn) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+// Ответ на завершение всех сессий сотрудника
+type RevokeStaffSessionsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RevokeStaffSessionsOut) Reset() {
+	*x = RevokeStaffSessionsOut{}
+	mi := &file_api_staff_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeStaffSessionsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeStaffSessionsOut) ProtoMessage() {}
+
+func (x *RevokeStaffSessionsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeStaffSessionsOut.ProtoReflect.Descriptor instead.
+func (*RevokeStaffSessionsOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RevokeStaffSessionsOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Активная сессия сотрудника
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent      string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Ip             string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"` // адрес клиента при входе
+	CreatedAt      int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastActivityAt int64  `protobuf:"varint,5,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	ExpiresAt      int64  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время истечения refresh токена в unix timestamp
+	Current        bool   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`                      // сессия, от имени которой выполнен запрос
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_api_staff_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Session) GetLastActivityAt() int64 {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return 0
+}
+
+func (x *Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// Запрос на выдачу токена сброса пароля
+type RequestPasswordResetIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaffId string `protobuf:"bytes,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+}
+
+func (x *RequestPasswordResetIn) Reset() {
+	*x = RequestPasswordResetIn{}
+	mi := &file_api_staff_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetIn) ProtoMessage() {}
+
+func (x *RequestPasswordResetIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetIn.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequestPasswordResetIn) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+// Ответ с токеном сброса пароля, который передается сотруднику
+type RequestPasswordResetOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResetToken string `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время истечения токена в unix timestamp
+}
+
+func (x *RequestPasswordResetOut) Reset() {
+	*x = RequestPasswordResetOut{}
+	mi := &file_api_staff_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetOut) ProtoMessage() {}
+
+func (x *RequestPasswordResetOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetOut.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RequestPasswordResetOut) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetOut) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Запрос на установку нового пароля по токену сброса
+type CompletePasswordResetIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResetToken  string `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *CompletePasswordResetIn) Reset() {
+	*x = CompletePasswordResetIn{}
+	mi := &file_api_staff_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePasswordResetIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePasswordResetIn) ProtoMessage() {}
+
+func (x *CompletePasswordResetIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePasswordResetIn.ProtoReflect.Descriptor instead.
+func (*CompletePasswordResetIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CompletePasswordResetIn) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *CompletePasswordResetIn) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Ответ на установку нового пароля
+type CompletePasswordResetOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *CompletePasswordResetOut) Reset() {
+	*x = CompletePasswordResetOut{}
+	mi := &file_api_staff_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePasswordResetOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePasswordResetOut) ProtoMessage() {}
+
+func (x *CompletePasswordResetOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePasswordResetOut.ProtoReflect.Descriptor instead.
+func (*CompletePasswordResetOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CompletePasswordResetOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос на завершение входа вторым фактором
+type VerifyMFAIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MfaToken     string `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code         string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                     // код TOTP
+	RecoveryCode string `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"` // резервный код, если нет доступа к приложению
+}
+
+func (x *VerifyMFAIn) Reset() {
+	*x = VerifyMFAIn{}
+	mi := &file_api_staff_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAIn) ProtoMessage() {}
+
+func (x *VerifyMFAIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAIn.ProtoReflect.Descriptor instead.
+func (*VerifyMFAIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VerifyMFAIn) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAIn) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyMFAIn) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+// Ответ на успешное завершение входа
+type VerifyMFAOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken            string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken           string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt              int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                        // время истечения токена в unix timestamp
+	RefreshExpiresAt       int64  `protobuf:"varint,4,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"` // время истечения refresh токена в unix timestamp
+	Staff                  *Staff `protobuf:"bytes,5,opt,name=staff,proto3" json:"staff,omitempty"`
+	PasswordChangeRequired bool   `protobuf:"varint,6,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+}
+
+func (x *VerifyMFAOut) Reset() {
+	*x = VerifyMFAOut{}
+	mi := &file_api_staff_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAOut) ProtoMessage() {}
+
+func (x *VerifyMFAOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAOut.ProtoReflect.Descriptor instead.
+func (*VerifyMFAOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyMFAOut) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAOut) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAOut) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *VerifyMFAOut) GetRefreshExpiresAt() int64 {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return 0
+}
+
+func (x *VerifyMFAOut) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+func (x *VerifyMFAOut) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+// Запрос на начало подключения TOTP
+type BeginTOTPEnrollmentIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BeginTOTPEnrollmentIn) Reset() {
+	*x = BeginTOTPEnrollmentIn{}
+	mi := &file_api_staff_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTOTPEnrollmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTOTPEnrollmentIn) ProtoMessage() {}
+
+func (x *BeginTOTPEnrollmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTOTPEnrollmentIn.ProtoReflect.Descriptor instead.
+func (*BeginTOTPEnrollmentIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{44}
+}
+
+// Ответ с секретом для приложения-аутентификатора
+type BeginTOTPEnrollmentOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret     string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"` // base32
+	OtpauthUri string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+}
+
+func (x *BeginTOTPEnrollmentOut) Reset() {
+	*x = BeginTOTPEnrollmentOut{}
+	mi := &file_api_staff_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTOTPEnrollmentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTOTPEnrollmentOut) ProtoMessage() {}
+
+func (x *BeginTOTPEnrollmentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTOTPEnrollmentOut.ProtoReflect.Descriptor instead.
+func (*BeginTOTPEnrollmentOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BeginTOTPEnrollmentOut) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTOTPEnrollmentOut) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// Запрос на подтверждение подключения TOTP
+type ConfirmTOTPEnrollmentIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ConfirmTOTPEnrollmentIn) Reset() {
+	*x = ConfirmTOTPEnrollmentIn{}
+	mi := &file_api_staff_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPEnrollmentIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPEnrollmentIn) ProtoMessage() {}
+
+func (x *ConfirmTOTPEnrollmentIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPEnrollmentIn.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPEnrollmentIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ConfirmTOTPEnrollmentIn) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Ответ с резервными кодами, которые показываются один раз
+type ConfirmTOTPEnrollmentOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *ConfirmTOTPEnrollmentOut) Reset() {
+	*x = ConfirmTOTPEnrollmentOut{}
+	mi := &file_api_staff_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPEnrollmentOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPEnrollmentOut) ProtoMessage() {}
+
+func (x *ConfirmTOTPEnrollmentOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPEnrollmentOut.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPEnrollmentOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConfirmTOTPEnrollmentOut) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// Запрос на отключение TOTP
+type DisableTOTPIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // код TOTP или резервный код
+}
+
+func (x *DisableTOTPIn) Reset() {
+	*x = DisableTOTPIn{}
+	mi := &file_api_staff_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPIn) ProtoMessage() {}
+
+func (x *DisableTOTPIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPIn.ProtoReflect.Descriptor instead.
+func (*DisableTOTPIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DisableTOTPIn) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Ответ на отключение TOTP
+type DisableTOTPOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DisableTOTPOut) Reset() {
+	*x = DisableTOTPOut{}
+	mi := &file_api_staff_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPOut) ProtoMessage() {}
+
+func (x *DisableTOTPOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPOut.ProtoReflect.Descriptor instead.
+func (*DisableTOTPOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DisableTOTPOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Запрос на получение ключей проверки подписи
+type GetSigningKeysIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSigningKeysIn) Reset() {
+	*x = GetSigningKeysIn{}
+	mi := &file_api_staff_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSigningKeysIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysIn) ProtoMessage() {}
+
+func (x *GetSigningKeysIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysIn.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{50}
+}
+
+// Ответ с ключами проверки подписи; пустой, если выдача JWT отключена
+type GetSigningKeysOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*JsonWebKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *GetSigningKeysOut) Reset() {
+	*x = GetSigningKeysOut{}
+	mi := &file_api_staff_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSigningKeysOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysOut) ProtoMessage() {}
+
+func (x *GetSigningKeysOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysOut.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSigningKeysOut) GetKeys() []*JsonWebKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Открытый ключ в формате JWK (RFC 7517)
+type JsonWebKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kid string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Kty string `protobuf:"bytes,2,opt,name=kty,proto3" json:"kty,omitempty"` // OKP или RSA
+	Alg string `protobuf:"bytes,3,opt,name=alg,proto3" json:"alg,omitempty"` // EdDSA или RS256
+	Use string `protobuf:"bytes,4,opt,name=use,proto3" json:"use,omitempty"`
+	Crv string `protobuf:"bytes,5,opt,name=crv,proto3" json:"crv,omitempty"` // для OKP
+	X   string `protobuf:"bytes,6,opt,name=x,proto3" json:"x,omitempty"`     // для OKP
+	N   string `protobuf:"bytes,7,opt,name=n,proto3" json:"n,omitempty"`     // для RSA
+	E   string `protobuf:"bytes,8,opt,name=e,proto3" json:"e,omitempty"`     // для RSA
+}
+
+func (x *JsonWebKey) Reset() {
+	*x = JsonWebKey{}
+	mi := &file_api_staff_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonWebKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonWebKey) ProtoMessage() {}
+
+func (x *JsonWebKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonWebKey.ProtoReflect.Descriptor instead.
+func (*JsonWebKey) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *JsonWebKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JsonWebKey) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+// Запрос на получение блокировок входа
+type ListLoginLockoutsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLoginLockoutsIn) Reset() {
+	*x = ListLoginLockoutsIn{}
+	mi := &file_api_staff_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginLockoutsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginLockoutsIn) ProtoMessage() {}
+
+func (x *ListLoginLockoutsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginLockoutsIn.ProtoReflect.Descriptor instead.
+func (*ListLoginLockoutsIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{53}
+}
+
+// Ответ со списком блокировок входа
+type ListLoginLockoutsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lockouts []*LoginLockout `protobuf:"bytes,1,rep,name=lockouts,proto3" json:"lockouts,omitempty"`
+}
+
+func (x *ListLoginLockoutsOut) Reset() {
+	*x = ListLoginLockoutsOut{}
+	mi := &file_api_staff_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginLockoutsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginLockoutsOut) ProtoMessage() {}
+
+func (x *ListLoginLockoutsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginLockoutsOut.ProtoReflect.Descriptor instead.
+func (*ListLoginLockoutsOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListLoginLockoutsOut) GetLockouts() []*LoginLockout {
+	if x != nil {
+		return x.Lockouts
+	}
+	return nil
+}
+
+// Запрос на снятие блокировки входа
+type ClearLoginLockoutIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope   string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`     // login или ip
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"` // логин или адрес клиента
+}
+
+func (x *ClearLoginLockoutIn) Reset() {
+	*x = ClearLoginLockoutIn{}
+	mi := &file_api_staff_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLoginLockoutIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLoginLockoutIn) ProtoMessage() {}
+
+func (x *ClearLoginLockoutIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLoginLockoutIn.ProtoReflect.Descriptor instead.
+func (*ClearLoginLockoutIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ClearLoginLockoutIn) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ClearLoginLockoutIn) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// Ответ на снятие блокировки входа
+type ClearLoginLockoutOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ClearLoginLockoutOut) Reset() {
+	*x = ClearLoginLockoutOut{}
+	mi := &file_api_staff_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLoginLockoutOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLoginLockoutOut) ProtoMessage() {}
+
+func (x *ClearLoginLockoutOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLoginLockoutOut.ProtoReflect.Descriptor instead.
+func (*ClearLoginLockoutOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ClearLoginLockoutOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Счетчик неудачных попыток входа по логину или адресу
+type LoginLockout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope         string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"` // login или ip
+	Subject       string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Failures      int32  `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	LockedUntil   int64  `protobuf:"varint,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"` // время окончания блокировки в unix timestamp, 0 если блокировки нет
+	LastFailureAt int64  `protobuf:"varint,5,opt,name=last_failure_at,json=lastFailureAt,proto3" json:"last_failure_at,omitempty"`
+}
+
+func (x *LoginLockout) Reset() {
+	*x = LoginLockout{}
+	mi := &file_api_staff_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginLockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginLockout) ProtoMessage() {}
+
+func (x *LoginLockout) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginLockout.ProtoReflect.Descriptor instead.
+func (*LoginLockout) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LoginLockout) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LoginLockout) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *LoginLockout) GetLockedUntil() int64 {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return 0
+}
+
+func (x *LoginLockout) GetLastFailureAt() int64 {
+	if x != nil {
+		return x.LastFailureAt
+	}
+	return 0
+}
+
+// Запрос на получение списка ролей
+type ListRolesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRolesIn) Reset() {
+	*x = ListRolesIn{}
+	mi := &file_api_staff_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesIn) ProtoMessage() {}
+
+func (x *ListRolesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesIn.ProtoReflect.Descriptor instead.
+func (*ListRolesIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{58}
+}
+
+// Ответ со списком ролей
+type ListRolesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *ListRolesOut) Reset() {
+	*x = ListRolesOut{}
+	mi := &file_api_staff_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesOut) ProtoMessage() {}
+
+func (x *ListRolesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesOut.ProtoReflect.Descriptor instead.
+func (*ListRolesOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListRolesOut) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Запрос на получение информации о роли
+type GetRoleIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRoleIn) Reset() {
+	*x = GetRoleIn{}
+	mi := &file_api_staff_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleIn) ProtoMessage() {}
+
+func (x *GetRoleIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleIn.ProtoReflect.Descriptor instead.
+func (*GetRoleIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetRoleIn) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ с информацией о роли
+type GetRoleOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *GetRoleOut) Reset() {
+	*x = GetRoleOut{}
+	mi := &file_api_staff_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleOut) ProtoMessage() {}
+
+func (x *GetRoleOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleOut.ProtoReflect.Descriptor instead.
+func (*GetRoleOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetRoleOut) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// Запрос на создание роли
+type CreateRoleIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateRoleIn) Reset() {
+	*x = CreateRoleIn{}
+	mi := &file_api_staff_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleIn) ProtoMessage() {}
+
+func (x *CreateRoleIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleIn.ProtoReflect.Descriptor instead.
+func (*CreateRoleIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateRoleIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Ответ с информацией о созданной роли
+type CreateRoleOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *CreateRoleOut) Reset() {
+	*x = CreateRoleOut{}
+	mi := &file_api_staff_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleOut) ProtoMessage() {}
+
+func (x *CreateRoleOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleOut.ProtoReflect.Descriptor instead.
+func (*CreateRoleOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateRoleOut) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// Запрос на обновление роли
+type UpdateRoleIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UpdateRoleIn) Reset() {
+	*x = UpdateRoleIn{}
+	mi := &file_api_staff_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleIn) ProtoMessage() {}
+
+func (x *UpdateRoleIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleIn.ProtoReflect.Descriptor instead.
+func (*UpdateRoleIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateRoleIn) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRoleIn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Ответ с обновленной информацией о роли
+type UpdateRoleOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *Role `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *UpdateRoleOut) Reset() {
+	*x = UpdateRoleOut{}
+	mi := &file_api_staff_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleOut) ProtoMessage() {}
+
+func (x *UpdateRoleOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleOut.ProtoReflect.Descriptor instead.
+func (*UpdateRoleOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateRoleOut) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// Запрос на удаление роли
+type DeleteRoleIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteRoleIn) Reset() {
+	*x = DeleteRoleIn{}
+	mi := &file_api_staff_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleIn) ProtoMessage() {}
+
+func (x *DeleteRoleIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleIn.ProtoReflect.Descriptor instead.
+func (*DeleteRoleIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteRoleIn) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на удаление роли
+type DeleteRoleOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteRoleOut) Reset() {
+	*x = DeleteRoleOut{}
+	mi := &file_api_staff_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleOut) ProtoMessage() {}
+
+func (x *DeleteRoleOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleOut.ProtoReflect.Descriptor instead.
+func (*DeleteRoleOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteRoleOut) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Структура данных роли
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_api_staff_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Role) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Запрос на получение списка политик доступа
+type ListAccessPoliciesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAccessPoliciesIn) Reset() {
+	*x = ListAccessPoliciesIn{}
+	mi := &file_api_staff_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessPoliciesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessPoliciesIn) ProtoMessage() {}
+
+func (x *ListAccessPoliciesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessPoliciesIn.ProtoReflect.Descriptor instead.
+func (*ListAccessPoliciesIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{69}
+}
+
+// Ответ со списком политик доступа
+type ListAccessPoliciesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies []*AccessPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *ListAccessPoliciesOut) Reset() {
+	*x = ListAccessPoliciesOut{}
+	mi := &file_api_staff_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessPoliciesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessPoliciesOut) ProtoMessage() {}
+
+func (x *ListAccessPoliciesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessPoliciesOut.ProtoReflect.Descriptor instead.
+func (*ListAccessPoliciesOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListAccessPoliciesOut) GetPolicies() []*AccessPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// Запрос на создание или изменение политики доступа
+type SetAccessPolicyIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *AccessPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetAccessPolicyIn) Reset() {
+	*x = SetAccessPolicyIn{}
+	mi := &file_api_staff_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccessPolicyIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccessPolicyIn) ProtoMessage() {}
+
+func (x *SetAccessPolicyIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccessPolicyIn.ProtoReflect.Descriptor instead.
+func (*SetAccessPolicyIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetAccessPolicyIn) GetPolicy() *AccessPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Ответ с сохраненной политикой доступа
+type SetAccessPolicyOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *AccessPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetAccessPolicyOut) Reset() {
+	*x = SetAccessPolicyOut{}
+	mi := &file_api_staff_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccessPolicyOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutIn) ProtoMessage() {}
+func (*SetAccessPolicyOut) ProtoMessage() {}
 
-func (x *LogoutIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[15]
+func (x *SetAccessPolicyOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,20 +3904,66 @@ func (x *LogoutIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutIn.ProtoReflect.Descriptor instead.
-func (*LogoutIn) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetAccessPolicyOut.ProtoReflect.Descriptor instead.
+func (*SetAccessPolicyOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *LogoutIn) GetAccessToken() string {
+func (x *SetAccessPolicyOut) GetPolicy() *AccessPolicy {
 	if x != nil {
-		return x.AccessToken
+		return x.Policy
+	}
+	return nil
+}
+
+// Запрос на удаление политики доступа
+type DeleteAccessPolicyIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *DeleteAccessPolicyIn) Reset() {
+	*x = DeleteAccessPolicyIn{}
+	mi := &file_api_staff_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccessPolicyIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccessPolicyIn) ProtoMessage() {}
+
+func (x *DeleteAccessPolicyIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccessPolicyIn.ProtoReflect.Descriptor instead.
+func (*DeleteAccessPolicyIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteAccessPolicyIn) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-// Ответ на выход из системы
-type LogoutOut struct {
+// Ответ на удаление политики доступа
+type DeleteAccessPolicyOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -950,21 +3971,21 @@ type LogoutOut struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *LogoutOut) Reset() {
-	*x = LogoutOut{}
-	mi := &file_api_staff_proto_msgTypes[16]
+func (x *DeleteAccessPolicyOut) Reset() {
+	*x = DeleteAccessPolicyOut{}
+	mi := &file_api_staff_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutOut) String() string {
+func (x *DeleteAccessPolicyOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutOut) ProtoMessage() {}
+func (*DeleteAccessPolicyOut) ProtoMessage() {}
 
-func (x *LogoutOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[16]
+func (x *DeleteAccessPolicyOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,42 +3996,45 @@ func (x *LogoutOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutOut.ProtoReflect.Descriptor instead.
-func (*LogoutOut) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteAccessPolicyOut.ProtoReflect.Descriptor instead.
+func (*DeleteAccessPolicyOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *LogoutOut) GetSuccess() bool {
+func (x *DeleteAccessPolicyOut) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-// Запрос на проверку авторизации
-type CheckAuthIn struct {
+// Политика доступа к методу: метод доступен ролям из role_ids и сотрудникам с любым из permissions
+type AccessPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Method      string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"` // полное имя метода, например /staff.StaffService/Get
+	RoleIds     []int32  `protobuf:"varint,2,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	UpdatedAt   int64    `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *CheckAuthIn) Reset() {
-	*x = CheckAuthIn{}
-	mi := &file_api_staff_proto_msgTypes[17]
+func (x *AccessPolicy) Reset() {
+	*x = AccessPolicy{}
+	mi := &file_api_staff_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAuthIn) String() string {
+func (x *AccessPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAuthIn) ProtoMessage() {}
+func (*AccessPolicy) ProtoMessage() {}
 
-func (x *CheckAuthIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[17]
+func (x *AccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,43 +4045,69 @@ func (x *CheckAuthIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAuthIn.ProtoReflect.Descriptor instead.
-func (*CheckAuthIn) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AccessPolicy.ProtoReflect.Descriptor instead.
+func (*AccessPolicy) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CheckAuthIn) GetAccessToken() string {
+func (x *AccessPolicy) GetMethod() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Method
 	}
 	return ""
 }
 
-// Ответ на проверку авторизации
-type CheckAuthOut struct {
+func (x *AccessPolicy) GetRoleIds() []int32 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *AccessPolicy) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccessPolicy) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Запрос на получение журнала аудита
+type ListAuditEventsIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authorized bool   `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
-	Staff      *Staff `protobuf:"bytes,2,opt,name=staff,proto3" json:"staff,omitempty"`
+	ActorId  *string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	TargetId *string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Action   *string `protobuf:"bytes,3,opt,name=action,proto3,oneof" json:"action,omitempty"` // например staff.update или auth.login
+	From     *int64  `protobuf:"varint,4,opt,name=from,proto3,oneof" json:"from,omitempty"`    // начало периода в unix timestamp, включительно
+	To       *int64  `protobuf:"varint,5,opt,name=to,proto3,oneof" json:"to,omitempty"`        // конец периода в unix timestamp, не включительно
+	PageSize int32   `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor   string  `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor из предыдущего ответа, пустой для первой страницы
 }
 
-func (x *CheckAuthOut) Reset() {
-	*x = CheckAuthOut{}
-	mi := &file_api_staff_proto_msgTypes[18]
+func (x *ListAuditEventsIn) Reset() {
+	*x = ListAuditEventsIn{}
+	mi := &file_api_staff_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAuthOut) String() string {
+func (x *ListAuditEventsIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAuthOut) ProtoMessage() {}
+func (*ListAuditEventsIn) ProtoMessage() {}
 
-func (x *CheckAuthOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[18]
+func (x *ListAuditEventsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,52 +4118,145 @@ func (x *CheckAuthOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAuthOut.ProtoReflect.Descriptor instead.
-func (*CheckAuthOut) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListAuditEventsIn.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsIn) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *CheckAuthOut) GetAuthorized() bool {
+func (x *ListAuditEventsIn) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsIn) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsIn) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsIn) GetFrom() int64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *ListAuditEventsIn) GetTo() int64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+func (x *ListAuditEventsIn) GetPageSize() int32 {
 	if x != nil {
-		return x.Authorized
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-func (x *CheckAuthOut) GetStaff() *Staff {
+func (x *ListAuditEventsIn) GetCursor() string {
 	if x != nil {
-		return x.Staff
+		return x.Cursor
+	}
+	return ""
+}
+
+// Ответ с записями журнала аудита от новых к старым
+type ListAuditEventsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events     []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor string        `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пустой, если записей больше нет
+}
+
+func (x *ListAuditEventsOut) Reset() {
+	*x = ListAuditEventsOut{}
+	mi := &file_api_staff_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsOut) ProtoMessage() {}
+
+func (x *ListAuditEventsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsOut.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsOut) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAuditEventsOut) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
 	}
 	return nil
 }
 
-// Запрос на смену пароля
-type ChangePasswordIn struct {
+func (x *ListAuditEventsOut) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Запись журнала аудита
+type AuditEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldPassword string `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
-	// access_token используется для идентификации пользователя
-	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Id         int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId    string         `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // пустой, если действие выполнено без сессии
+	Action     string         `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType string         `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId   string         `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Changes    []*AuditChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	Ip         string         `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt  int64          `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *ChangePasswordIn) Reset() {
-	*x = ChangePasswordIn{}
-	mi := &file_api_staff_proto_msgTypes[19]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_api_staff_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordIn) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordIn) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *ChangePasswordIn) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[19]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,56 +4267,93 @@ func (x *ChangePasswordIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordIn.ProtoReflect.Descriptor instead.
-func (*ChangePasswordIn) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ChangePasswordIn) GetOldPassword() string {
+func (x *AuditEvent) GetId() int64 {
 	if x != nil {
-		return x.OldPassword
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *ChangePasswordIn) GetNewPassword() string {
+func (x *AuditEvent) GetAction() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Action
 	}
 	return ""
 }
 
-func (x *ChangePasswordIn) GetAccessToken() string {
+func (x *AuditEvent) GetTargetType() string {
 	if x != nil {
-		return x.AccessToken
+		return x.TargetType
 	}
 	return ""
 }
 
-// Ответ на смену пароля
-type ChangePasswordOut struct {
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Изменение поля; значения в формате JSON
+type AuditChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (x *ChangePasswordOut) Reset() {
-	*x = ChangePasswordOut{}
-	mi := &file_api_staff_proto_msgTypes[20]
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	mi := &file_api_staff_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordOut) String() string {
+func (x *AuditChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordOut) ProtoMessage() {}
+func (*AuditChange) ProtoMessage() {}
 
-func (x *ChangePasswordOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[20]
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staff_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,16 +4364,30 @@ func (x *ChangePasswordOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordOut.ProtoReflect.Descriptor instead.
-func (*ChangePasswordOut) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_api_staff_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ChangePasswordOut) GetSuccess() bool {
+func (x *AuditChange) GetField() string {
 	if x != nil {
-		return x.Success
+		return x.Field
 	}
-	return false
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
 }
 
 // Структура разрешений сотрудника
@@ -1202,12 +4396,12 @@ type Permissions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Access []string `protobuf:"bytes,1,rep,name=access,proto3" json:"access,omitempty"`
+	Access []string `protobuf:"bytes,1,rep,name=access,proto3" json:"access,omitempty"` // разрешения вида staff:read, staff:write, staff:delete, roles:read, roles:write
 }
 
 func (x *Permissions) Reset() {
 	*x = Permissions{}
-	mi := &file_api_staff_proto_msgTypes[21]
+	mi := &file_api_staff_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1219,7 +4413,7 @@ func (x *Permissions) String() string {
 func (*Permissions) ProtoMessage() {}
 
 func (x *Permissions) ProtoReflect() protoreflect.Message {
-	mi := &file_api_staff_proto_msgTypes[21]
+	mi := &file_api_staff_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,7 +4426,7 @@ func (x *Permissions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Permissions.ProtoReflect.Descriptor instead.
 func (*Permissions) Descriptor() ([]byte, []int) {
-	return file_api_staff_proto_rawDescGZIP(), []int{21}
+	return file_api_staff_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *Permissions) GetAccess() []string {
@@ -1262,8 +4456,8 @@ var file_api_staff_proto_rawDesc = []byte{
 	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a,
 	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74,
 	0x61, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x22, 0xb4,
-	0x01, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x22, 0xaf,
+	0x02, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x6c,
 	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f,
 	0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
@@ -1271,51 +4465,91 @@ var file_api_staff_proto_rawDesc = []byte{
 	0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
 	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66,
 	0x66, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x02, 0x52,
-	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x75, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x22, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x25, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x06, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x72,
-	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06,
-	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74,
-	0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3b,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x14, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52,
+	0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f,
+	0x6d, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x2f, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x22, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a,
+	0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x1e, 0x0a, 0x0c, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0d, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x22, 0x1e, 0x0a, 0x0c, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0d, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x22, 0x19,
+	0x0a, 0x07, 0x50, 0x75, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x08, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0xc4, 0x01, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x1c, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75,
+	0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc6, 0x03, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x66, 0x66, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3b,
 	0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
 	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
 	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x08,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xe3, 0x02, 0x0a, 0x08,
 	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
 	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72,
@@ -1325,79 +4559,461 @@ var file_api_staff_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
 	0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
 	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x66, 0x66, 0x22, 0x35, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x0f, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x41, 0x74, 0x22, 0x2d, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x49, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x0b, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x0c,
+	0x61, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x66, 0x61, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x66, 0x61, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x66, 0x61, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x66, 0x61, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0x35, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x16, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x08, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x12, 0x25, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25,
+	0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x34, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75,
+	0x74, 0x68, 0x49, 0x6e, 0x12, 0x25, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0c,
 	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
 	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05,
 	0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x74,
 	0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66,
-	0x22, 0x7b, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
-	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a,
-	0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4f,
-	0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x25, 0x0a, 0x0b,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x32, 0x85, 0x04, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0c, 0x2e, 0x73, 0x74,
-	0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x0d, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x1a, 0x0e,
-	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00,
-	0x12, 0x2a, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x73,
-	0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a,
-	0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
-	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
-	0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x1a, 0x13, 0x2e,
-	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x4f,
-	0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x1a,
-	0x18, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x70,
-	0x6b, 0x67, 0x2f, 0x73, 0x74, 0x61, 0x66, 0x66, 0x3b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x16, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x10, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a, 0x11, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4f, 0x75, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x22, 0x3f,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x30, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x1a, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x22, 0x40, 0x0a, 0x19, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x30, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x22,
+	0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x32, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x07,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x22, 0x59, 0x0a,
+	0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x49, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x63, 0x0a,
+	0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x66, 0x61, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x66, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41,
+	0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x12, 0x38, 0x0a, 0x18,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54,
+	0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x22,
+	0x51, 0x0a, 0x16, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x55,
+	0x72, 0x69, 0x22, 0x2d, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54,
+	0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x41, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x4f, 0x54, 0x50, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x49, 0x6e, 0x22, 0x3a, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x25,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x57, 0x65, 0x62, 0x4b, 0x65, 0x79, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0a, 0x4a, 0x73, 0x6f, 0x6e, 0x57, 0x65,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x72, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x76, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x22,
+	0x47, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x30, 0x0a, 0x14, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x22, 0x31, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x1b, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x32, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x30, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2a,
+	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x49, 0x6e, 0x22, 0x48, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49,
+	0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x41,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x12,
+	0x1e, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x29, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xea, 0x01, 0x0a, 0x0a, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x0b, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x32, 0xd0, 0x12, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x24, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x0d, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0d, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x1a, 0x0e, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0a, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x1a, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x05, 0x50, 0x75, 0x72, 0x67, 0x65, 0x12, 0x0e, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x2a, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x1a, 0x18,
+	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c,
+	0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e,
+	0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x6c, 0x6c, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x14, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x49,
+	0x6e, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x1a, 0x1f, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x12, 0x12, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x49, 0x6e,
+	0x1a, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d,
+	0x46, 0x41, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x4f, 0x54, 0x50,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x1d, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x49, 0x6e, 0x1a, 0x15,
+	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f,
+	0x54, 0x50, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73,
+	0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x49, 0x6e, 0x1a,
+	0x1b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x1a,
+	0x1b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x36,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x1a,
+	0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x49,
+	0x6e, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x1b, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x18, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x3b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1412,66 +5028,193 @@ func file_api_staff_proto_rawDescGZIP() []byte {
 	return file_api_staff_proto_rawDescData
 }
 
-var file_api_staff_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_api_staff_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_api_staff_proto_goTypes = []any{
-	(*GetIn)(nil),             // 0: staff.GetIn
-	(*GetOut)(nil),            // 1: staff.GetOut
-	(*CreateIn)(nil),          // 2: staff.CreateIn
-	(*CreateOut)(nil),         // 3: staff.CreateOut
-	(*UpdateIn)(nil),          // 4: staff.UpdateIn
-	(*UpdateOut)(nil),         // 5: staff.UpdateOut
-	(*DeleteIn)(nil),          // 6: staff.DeleteIn
-	(*DeleteOut)(nil),         // 7: staff.DeleteOut
-	(*ListIn)(nil),            // 8: staff.ListIn
-	(*ListOut)(nil),           // 9: staff.ListOut
-	(*Staff)(nil),             // 10: staff.Staff
-	(*LoginIn)(nil),           // 11: staff.LoginIn
-	(*LoginOut)(nil),          // 12: staff.LoginOut
-	(*RefreshTokenIn)(nil),    // 13: staff.RefreshTokenIn
-	(*RefreshTokenOut)(nil),   // 14: staff.RefreshTokenOut
-	(*LogoutIn)(nil),          // 15: staff.LogoutIn
-	(*LogoutOut)(nil),         // 16: staff.LogoutOut
-	(*CheckAuthIn)(nil),       // 17: staff.CheckAuthIn
-	(*CheckAuthOut)(nil),      // 18: staff.CheckAuthOut
-	(*ChangePasswordIn)(nil),  // 19: staff.ChangePasswordIn
-	(*ChangePasswordOut)(nil), // 20: staff.ChangePasswordOut
-	(*Permissions)(nil),       // 21: staff.Permissions
+	(*GetIn)(nil),                     // 0: staff.GetIn
+	(*GetOut)(nil),                    // 1: staff.GetOut
+	(*CreateIn)(nil),                  // 2: staff.CreateIn
+	(*CreateOut)(nil),                 // 3: staff.CreateOut
+	(*UpdateIn)(nil),                  // 4: staff.UpdateIn
+	(*UpdateOut)(nil),                 // 5: staff.UpdateOut
+	(*DeleteIn)(nil),                  // 6: staff.DeleteIn
+	(*DeleteOut)(nil),                 // 7: staff.DeleteOut
+	(*DeactivateIn)(nil),              // 8: staff.DeactivateIn
+	(*DeactivateOut)(nil),             // 9: staff.DeactivateOut
+	(*ReactivateIn)(nil),              // 10: staff.ReactivateIn
+	(*ReactivateOut)(nil),             // 11: staff.ReactivateOut
+	(*PurgeIn)(nil),                   // 12: staff.PurgeIn
+	(*PurgeOut)(nil),                  // 13: staff.PurgeOut
+	(*ListIn)(nil),                    // 14: staff.ListIn
+	(*ListOut)(nil),                   // 15: staff.ListOut
+	(*Staff)(nil),                     // 16: staff.Staff
+	(*LoginIn)(nil),                   // 17: staff.LoginIn
+	(*LoginOut)(nil),                  // 18: staff.LoginOut
+	(*RefreshTokenIn)(nil),            // 19: staff.RefreshTokenIn
+	(*RefreshTokenOut)(nil),           // 20: staff.RefreshTokenOut
+	(*LogoutIn)(nil),                  // 21: staff.LogoutIn
+	(*LogoutOut)(nil),                 // 22: staff.LogoutOut
+	(*CheckAuthIn)(nil),               // 23: staff.CheckAuthIn
+	(*CheckAuthOut)(nil),              // 24: staff.CheckAuthOut
+	(*ChangePasswordIn)(nil),          // 25: staff.ChangePasswordIn
+	(*ChangePasswordOut)(nil),         // 26: staff.ChangePasswordOut
+	(*ListMySessionsIn)(nil),          // 27: staff.ListMySessionsIn
+	(*ListMySessionsOut)(nil),         // 28: staff.ListMySessionsOut
+	(*RevokeSessionIn)(nil),           // 29: staff.RevokeSessionIn
+	(*RevokeSessionOut)(nil),          // 30: staff.RevokeSessionOut
+	(*RevokeAllOtherSessionsIn)(nil),  // 31: staff.RevokeAllOtherSessionsIn
+	(*RevokeAllOtherSessionsOut)(nil), // 32: staff.RevokeAllOtherSessionsOut
+	(*ListStaffSessionsIn)(nil),       // 33: staff.ListStaffSessionsIn
+	(*ListStaffSessionsOut)(nil),      // 34: staff.ListStaffSessionsOut
+	(*RevokeStaffSessionsIn)(nil),     // 35: staff.RevokeStaffSessionsIn
+	(*RevokeStaffSessionsOut)(nil),    // 36: staff.RevokeStaffSessionsOut
+	(*Session)(nil),                   // 37: staff.Session
+	(*RequestPasswordResetIn)(nil),    // 38: staff.RequestPasswordResetIn
+	(*RequestPasswordResetOut)(nil),   // 39: staff.RequestPasswordResetOut
+	(*CompletePasswordResetIn)(nil),   // 40: staff.CompletePasswordResetIn
+	(*CompletePasswordResetOut)(nil),  // 41: staff.CompletePasswordResetOut
+	(*VerifyMFAIn)(nil),               // 42: staff.VerifyMFAIn
+	(*VerifyMFAOut)(nil),              // 43: staff.VerifyMFAOut
+	(*BeginTOTPEnrollmentIn)(nil),     // 44: staff.BeginTOTPEnrollmentIn
+	(*BeginTOTPEnrollmentOut)(nil),    // 45: staff.BeginTOTPEnrollmentOut
+	(*ConfirmTOTPEnrollmentIn)(nil),   // 46: staff.ConfirmTOTPEnrollmentIn
+	(*ConfirmTOTPEnrollmentOut)(nil),  // 47: staff.ConfirmTOTPEnrollmentOut
+	(*DisableTOTPIn)(nil),             // 48: staff.DisableTOTPIn
+	(*DisableTOTPOut)(nil),            // 49: staff.DisableTOTPOut
+	(*GetSigningKeysIn)(nil),          // 50: staff.GetSigningKeysIn
+	(*GetSigningKeysOut)(nil),         // 51: staff.GetSigningKeysOut
+	(*JsonWebKey)(nil),                // 52: staff.JsonWebKey
+	(*ListLoginLockoutsIn)(nil),       // 53: staff.ListLoginLockoutsIn
+	(*ListLoginLockoutsOut)(nil),      // 54: staff.ListLoginLockoutsOut
+	(*ClearLoginLockoutIn)(nil),       // 55: staff.ClearLoginLockoutIn
+	(*ClearLoginLockoutOut)(nil),      // 56: staff.ClearLoginLockoutOut
+	(*LoginLockout)(nil),              // 57: staff.LoginLockout
+	(*ListRolesIn)(nil),               // 58: staff.ListRolesIn
+	(*ListRolesOut)(nil),              // 59: staff.ListRolesOut
+	(*GetRoleIn)(nil),                 // 60: staff.GetRoleIn
+	(*GetRoleOut)(nil),                // 61: staff.GetRoleOut
+	(*CreateRoleIn)(nil),              // 62: staff.CreateRoleIn
+	(*CreateRoleOut)(nil),             // 63: staff.CreateRoleOut
+	(*UpdateRoleIn)(nil),              // 64: staff.UpdateRoleIn
+	(*UpdateRoleOut)(nil),             // 65: staff.UpdateRoleOut
+	(*DeleteRoleIn)(nil),              // 66: staff.DeleteRoleIn
+	(*DeleteRoleOut)(nil),             // 67: staff.DeleteRoleOut
+	(*Role)(nil),                      // 68: staff.Role
+	(*ListAccessPoliciesIn)(nil),      // 69: staff.ListAccessPoliciesIn
+	(*ListAccessPoliciesOut)(nil),     // 70: staff.ListAccessPoliciesOut
+	(*SetAccessPolicyIn)(nil),         // 71: staff.SetAccessPolicyIn
+	(*SetAccessPolicyOut)(nil),        // 72: staff.SetAccessPolicyOut
+	(*DeleteAccessPolicyIn)(nil),      // 73: staff.DeleteAccessPolicyIn
+	(*DeleteAccessPolicyOut)(nil),     // 74: staff.DeleteAccessPolicyOut
+	(*AccessPolicy)(nil),              // 75: staff.AccessPolicy
+	(*ListAuditEventsIn)(nil),         // 76: staff.ListAuditEventsIn
+	(*ListAuditEventsOut)(nil),        // 77: staff.ListAuditEventsOut
+	(*AuditEvent)(nil),                // 78: staff.AuditEvent
+	(*AuditChange)(nil),               // 79: staff.AuditChange
+	(*Permissions)(nil),               // 80: staff.Permissions
 }
 var file_api_staff_proto_depIdxs = []int32{
-	10, // 0: staff.GetOut.staff:type_name -> staff.Staff
-	21, // 1: staff.CreateIn.permissions:type_name -> staff.Permissions
-	10, // 2: staff.CreateOut.staff:type_name -> staff.Staff
-	21, // 3: staff.UpdateIn.permissions:type_name -> staff.Permissions
-	10, // 4: staff.UpdateOut.staff:type_name -> staff.Staff
-	10, // 5: staff.ListOut.staff:type_name -> staff.Staff
-	21, // 6: staff.Staff.permissions:type_name -> staff.Permissions
-	10, // 7: staff.LoginOut.staff:type_name -> staff.Staff
-	10, // 8: staff.CheckAuthOut.staff:type_name -> staff.Staff
-	0,  // 9: staff.StaffService.Get:input_type -> staff.GetIn
-	2,  // 10: staff.StaffService.Create:input_type -> staff.CreateIn
-	4,  // 11: staff.StaffService.Update:input_type -> staff.UpdateIn
-	6,  // 12: staff.StaffService.Delete:input_type -> staff.DeleteIn
-	8,  // 13: staff.StaffService.List:input_type -> staff.ListIn
-	11, // 14: staff.StaffService.Login:input_type -> staff.LoginIn
-	13, // 15: staff.StaffService.RefreshToken:input_type -> staff.RefreshTokenIn
-	15, // 16: staff.StaffService.Logout:input_type -> staff.LogoutIn
-	17, // 17: staff.StaffService.CheckAuth:input_type -> staff.CheckAuthIn
-	19, // 18: staff.StaffService.ChangePassword:input_type -> staff.ChangePasswordIn
-	1,  // 19: staff.StaffService.Get:output_type -> staff.GetOut
-	3,  // 20: staff.StaffService.Create:output_type -> staff.CreateOut
-	5,  // 21: staff.StaffService.Update:output_type -> staff.UpdateOut
-	7,  // 22: staff.StaffService.Delete:output_type -> staff.DeleteOut
-	9,  // 23: staff.StaffService.List:output_type -> staff.ListOut
-	12, // 24: staff.StaffService.Login:output_type -> staff.LoginOut
-	14, // 25: staff.StaffService.RefreshToken:output_type -> staff.RefreshTokenOut
-	16, // 26: staff.StaffService.Logout:output_type -> staff.LogoutOut
-	18, // 27: staff.StaffService.CheckAuth:output_type -> staff.CheckAuthOut
-	20, // 28: staff.StaffService.ChangePassword:output_type -> staff.ChangePasswordOut
-	19, // [19:29] is the sub-list for method output_type
-	9,  // [9:19] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	16, // 0: staff.GetOut.staff:type_name -> staff.Staff
+	80, // 1: staff.CreateIn.permissions:type_name -> staff.Permissions
+	16, // 2: staff.CreateOut.staff:type_name -> staff.Staff
+	80, // 3: staff.UpdateIn.permissions:type_name -> staff.Permissions
+	16, // 4: staff.UpdateOut.staff:type_name -> staff.Staff
+	16, // 5: staff.DeactivateOut.staff:type_name -> staff.Staff
+	16, // 6: staff.ReactivateOut.staff:type_name -> staff.Staff
+	16, // 7: staff.ListOut.staff:type_name -> staff.Staff
+	80, // 8: staff.Staff.permissions:type_name -> staff.Permissions
+	16, // 9: staff.LoginOut.staff:type_name -> staff.Staff
+	16, // 10: staff.CheckAuthOut.staff:type_name -> staff.Staff
+	37, // 11: staff.ListMySessionsOut.sessions:type_name -> staff.Session
+	37, // 12: staff.ListStaffSessionsOut.sessions:type_name -> staff.Session
+	16, // 13: staff.VerifyMFAOut.staff:type_name -> staff.Staff
+	52, // 14: staff.GetSigningKeysOut.keys:type_name -> staff.JsonWebKey
+	57, // 15: staff.ListLoginLockoutsOut.lockouts:type_name -> staff.LoginLockout
+	68, // 16: staff.ListRolesOut.roles:type_name -> staff.Role
+	68, // 17: staff.GetRoleOut.role:type_name -> staff.Role
+	68, // 18: staff.CreateRoleOut.role:type_name -> staff.Role
+	68, // 19: staff.UpdateRoleOut.role:type_name -> staff.Role
+	75, // 20: staff.ListAccessPoliciesOut.policies:type_name -> staff.AccessPolicy
+	75, // 21: staff.SetAccessPolicyIn.policy:type_name -> staff.AccessPolicy
+	75, // 22: staff.SetAccessPolicyOut.policy:type_name -> staff.AccessPolicy
+	78, // 23: staff.ListAuditEventsOut.events:type_name -> staff.AuditEvent
+	79, // 24: staff.AuditEvent.changes:type_name -> staff.AuditChange
+	0,  // 25: staff.StaffService.Get:input_type -> staff.GetIn
+	2,  // 26: staff.StaffService.Create:input_type -> staff.CreateIn
+	4,  // 27: staff.StaffService.Update:input_type -> staff.UpdateIn
+	6,  // 28: staff.StaffService.Delete:input_type -> staff.DeleteIn
+	14, // 29: staff.StaffService.List:input_type -> staff.ListIn
+	8,  // 30: staff.StaffService.Deactivate:input_type -> staff.DeactivateIn
+	10, // 31: staff.StaffService.Reactivate:input_type -> staff.ReactivateIn
+	12, // 32: staff.StaffService.Purge:input_type -> staff.PurgeIn
+	17, // 33: staff.StaffService.Login:input_type -> staff.LoginIn
+	19, // 34: staff.StaffService.RefreshToken:input_type -> staff.RefreshTokenIn
+	21, // 35: staff.StaffService.Logout:input_type -> staff.LogoutIn
+	23, // 36: staff.StaffService.CheckAuth:input_type -> staff.CheckAuthIn
+	25, // 37: staff.StaffService.ChangePassword:input_type -> staff.ChangePasswordIn
+	27, // 38: staff.StaffService.ListMySessions:input_type -> staff.ListMySessionsIn
+	29, // 39: staff.StaffService.RevokeSession:input_type -> staff.RevokeSessionIn
+	31, // 40: staff.StaffService.RevokeAllOtherSessions:input_type -> staff.RevokeAllOtherSessionsIn
+	33, // 41: staff.StaffService.ListStaffSessions:input_type -> staff.ListStaffSessionsIn
+	35, // 42: staff.StaffService.RevokeStaffSessions:input_type -> staff.RevokeStaffSessionsIn
+	38, // 43: staff.StaffService.RequestPasswordReset:input_type -> staff.RequestPasswordResetIn
+	40, // 44: staff.StaffService.CompletePasswordReset:input_type -> staff.CompletePasswordResetIn
+	42, // 45: staff.StaffService.VerifyMFA:input_type -> staff.VerifyMFAIn
+	44, // 46: staff.StaffService.BeginTOTPEnrollment:input_type -> staff.BeginTOTPEnrollmentIn
+	46, // 47: staff.StaffService.ConfirmTOTPEnrollment:input_type -> staff.ConfirmTOTPEnrollmentIn
+	48, // 48: staff.StaffService.DisableTOTP:input_type -> staff.DisableTOTPIn
+	50, // 49: staff.StaffService.GetSigningKeys:input_type -> staff.GetSigningKeysIn
+	53, // 50: staff.StaffService.ListLoginLockouts:input_type -> staff.ListLoginLockoutsIn
+	55, // 51: staff.StaffService.ClearLoginLockout:input_type -> staff.ClearLoginLockoutIn
+	58, // 52: staff.StaffService.ListRoles:input_type -> staff.ListRolesIn
+	60, // 53: staff.StaffService.GetRole:input_type -> staff.GetRoleIn
+	62, // 54: staff.StaffService.CreateRole:input_type -> staff.CreateRoleIn
+	64, // 55: staff.StaffService.UpdateRole:input_type -> staff.UpdateRoleIn
+	66, // 56: staff.StaffService.DeleteRole:input_type -> staff.DeleteRoleIn
+	69, // 57: staff.StaffService.ListAccessPolicies:input_type -> staff.ListAccessPoliciesIn
+	71, // 58: staff.StaffService.SetAccessPolicy:input_type -> staff.SetAccessPolicyIn
+	73, // 59: staff.StaffService.DeleteAccessPolicy:input_type -> staff.DeleteAccessPolicyIn
+	76, // 60: staff.StaffService.ListAuditEvents:input_type -> staff.ListAuditEventsIn
+	1,  // 61: staff.StaffService.Get:output_type -> staff.GetOut
+	3,  // 62: staff.StaffService.Create:output_type -> staff.CreateOut
+	5,  // 63: staff.StaffService.Update:output_type -> staff.UpdateOut
+	7,  // 64: staff.StaffService.Delete:output_type -> staff.DeleteOut
+	15, // 65: staff.StaffService.List:output_type -> staff.ListOut
+	9,  // 66: staff.StaffService.Deactivate:output_type -> staff.DeactivateOut
+	11, // 67: staff.StaffService.Reactivate:output_type -> staff.ReactivateOut
+	13, // 68: staff.StaffService.Purge:output_type -> staff.PurgeOut
+	18, // 69: staff.StaffService.Login:output_type -> staff.LoginOut
+	20, // 70: staff.StaffService.RefreshToken:output_type -> staff.RefreshTokenOut
+	22, // 71: staff.StaffService.Logout:output_type -> staff.LogoutOut
+	24, // 72: staff.StaffService.CheckAuth:output_type -> staff.CheckAuthOut
+	26, // 73: staff.StaffService.ChangePassword:output_type -> staff.ChangePasswordOut
+	28, // 74: staff.StaffService.ListMySessions:output_type -> staff.ListMySessionsOut
+	30, // 75: staff.StaffService.RevokeSession:output_type -> staff.RevokeSessionOut
+	32, // 76: staff.StaffService.RevokeAllOtherSessions:output_type -> staff.RevokeAllOtherSessionsOut
+	34, // 77: staff.StaffService.ListStaffSessions:output_type -> staff.ListStaffSessionsOut
+	36, // 78: staff.StaffService.RevokeStaffSessions:output_type -> staff.RevokeStaffSessionsOut
+	39, // 79: staff.StaffService.RequestPasswordReset:output_type -> staff.RequestPasswordResetOut
+	41, // 80: staff.StaffService.CompletePasswordReset:output_type -> staff.CompletePasswordResetOut
+	43, // 81: staff.StaffService.VerifyMFA:output_type -> staff.VerifyMFAOut
+	45, // 82: staff.StaffService.BeginTOTPEnrollment:output_type -> staff.BeginTOTPEnrollmentOut
+	47, // 83: staff.StaffService.ConfirmTOTPEnrollment:output_type -> staff.ConfirmTOTPEnrollmentOut
+	49, // 84: staff.StaffService.DisableTOTP:output_type -> staff.DisableTOTPOut
+	51, // 85: staff.StaffService.GetSigningKeys:output_type -> staff.GetSigningKeysOut
+	54, // 86: staff.StaffService.ListLoginLockouts:output_type -> staff.ListLoginLockoutsOut
+	56, // 87: staff.StaffService.ClearLoginLockout:output_type -> staff.ClearLoginLockoutOut
+	59, // 88: staff.StaffService.ListRoles:output_type -> staff.ListRolesOut
+	61, // 89: staff.StaffService.GetRole:output_type -> staff.GetRoleOut
+	63, // 90: staff.StaffService.CreateRole:output_type -> staff.CreateRoleOut
+	65, // 91: staff.StaffService.UpdateRole:output_type -> staff.UpdateRoleOut
+	67, // 92: staff.StaffService.DeleteRole:output_type -> staff.DeleteRoleOut
+	70, // 93: staff.StaffService.ListAccessPolicies:output_type -> staff.ListAccessPoliciesOut
+	72, // 94: staff.StaffService.SetAccessPolicy:output_type -> staff.SetAccessPolicyOut
+	74, // 95: staff.StaffService.DeleteAccessPolicy:output_type -> staff.DeleteAccessPolicyOut
+	77, // 96: staff.StaffService.ListAuditEvents:output_type -> staff.ListAuditEventsOut
+	61, // [61:97] is the sub-list for method output_type
+	25, // [25:61] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_api_staff_proto_init() }
@@ -1480,14 +5223,16 @@ func file_api_staff_proto_init() {
 		return
 	}
 	file_api_staff_proto_msgTypes[4].OneofWrappers = []any{}
-	file_api_staff_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_staff_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_staff_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_staff_proto_msgTypes[76].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_staff_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
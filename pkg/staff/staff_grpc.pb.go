@@ -20,16 +20,42 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StaffService_Get_FullMethodName            = "/staff.StaffService/Get"
-	StaffService_Create_FullMethodName         = "/staff.StaffService/Create"
-	StaffService_Update_FullMethodName         = "/staff.StaffService/Update"
-	StaffService_Delete_FullMethodName         = "/staff.StaffService/Delete"
-	StaffService_List_FullMethodName           = "/staff.StaffService/List"
-	StaffService_Login_FullMethodName          = "/staff.StaffService/Login"
-	StaffService_RefreshToken_FullMethodName   = "/staff.StaffService/RefreshToken"
-	StaffService_Logout_FullMethodName         = "/staff.StaffService/Logout"
-	StaffService_CheckAuth_FullMethodName      = "/staff.StaffService/CheckAuth"
-	StaffService_ChangePassword_FullMethodName = "/staff.StaffService/ChangePassword"
+	StaffService_Get_FullMethodName                    = "/staff.StaffService/Get"
+	StaffService_Create_FullMethodName                 = "/staff.StaffService/Create"
+	StaffService_Update_FullMethodName                 = "/staff.StaffService/Update"
+	StaffService_Delete_FullMethodName                 = "/staff.StaffService/Delete"
+	StaffService_List_FullMethodName                   = "/staff.StaffService/List"
+	StaffService_Deactivate_FullMethodName             = "/staff.StaffService/Deactivate"
+	StaffService_Reactivate_FullMethodName             = "/staff.StaffService/Reactivate"
+	StaffService_Purge_FullMethodName                  = "/staff.StaffService/Purge"
+	StaffService_Login_FullMethodName                  = "/staff.StaffService/Login"
+	StaffService_RefreshToken_FullMethodName           = "/staff.StaffService/RefreshToken"
+	StaffService_Logout_FullMethodName                 = "/staff.StaffService/Logout"
+	StaffService_CheckAuth_FullMethodName              = "/staff.StaffService/CheckAuth"
+	StaffService_ChangePassword_FullMethodName         = "/staff.StaffService/ChangePassword"
+	StaffService_ListMySessions_FullMethodName         = "/staff.StaffService/ListMySessions"
+	StaffService_RevokeSession_FullMethodName          = "/staff.StaffService/RevokeSession"
+	StaffService_RevokeAllOtherSessions_FullMethodName = "/staff.StaffService/RevokeAllOtherSessions"
+	StaffService_ListStaffSessions_FullMethodName      = "/staff.StaffService/ListStaffSessions"
+	StaffService_RevokeStaffSessions_FullMethodName    = "/staff.StaffService/RevokeStaffSessions"
+	StaffService_RequestPasswordReset_FullMethodName   = "/staff.StaffService/RequestPasswordReset"
+	StaffService_CompletePasswordReset_FullMethodName  = "/staff.StaffService/CompletePasswordReset"
+	StaffService_VerifyMFA_FullMethodName              = "/staff.StaffService/VerifyMFA"
+	StaffService_BeginTOTPEnrollment_FullMethodName    = "/staff.StaffService/BeginTOTPEnrollment"
+	StaffService_ConfirmTOTPEnrollment_FullMethodName  = "/staff.StaffService/ConfirmTOTPEnrollment"
+	StaffService_DisableTOTP_FullMethodName            = "/staff.StaffService/DisableTOTP"
+	StaffService_GetSigningKeys_FullMethodName         = "/staff.StaffService/GetSigningKeys"
+	StaffService_ListLoginLockouts_FullMethodName      = "/staff.StaffService/ListLoginLockouts"
+	StaffService_ClearLoginLockout_FullMethodName      = "/staff.StaffService/ClearLoginLockout"
+	StaffService_ListRoles_FullMethodName              = "/staff.StaffService/ListRoles"
+	StaffService_GetRole_FullMethodName                = "/staff.StaffService/GetRole"
+	StaffService_CreateRole_FullMethodName             = "/staff.StaffService/CreateRole"
+	StaffService_UpdateRole_FullMethodName             = "/staff.StaffService/UpdateRole"
+	StaffService_DeleteRole_FullMethodName             = "/staff.StaffService/DeleteRole"
+	StaffService_ListAccessPolicies_FullMethodName     = "/staff.StaffService/ListAccessPolicies"
+	StaffService_SetAccessPolicy_FullMethodName        = "/staff.StaffService/SetAccessPolicy"
+	StaffService_DeleteAccessPolicy_FullMethodName     = "/staff.StaffService/DeleteAccessPolicy"
+	StaffService_ListAuditEvents_FullMethodName        = "/staff.StaffService/ListAuditEvents"
 )
 
 // StaffServiceClient is the client API for StaffService service.
@@ -44,10 +70,16 @@ type StaffServiceClient interface {
 	Create(ctx context.Context, in *CreateIn, opts ...grpc.CallOption) (*CreateOut, error)
 	// Обновление информации о сотруднике
 	Update(ctx context.Context, in *UpdateIn, opts ...grpc.CallOption) (*UpdateOut, error)
-	// Удаление сотрудника
+	// Удаление сотрудника. Запись сохраняется до окончательного удаления методом Purge
 	Delete(ctx context.Context, in *DeleteIn, opts ...grpc.CallOption) (*DeleteOut, error)
 	// Получение списка сотрудников с фильтрацией и пагинацией
 	List(ctx context.Context, in *ListIn, opts ...grpc.CallOption) (*ListOut, error)
+	// Отключение учетной записи сотрудника с завершением всех его сессий
+	Deactivate(ctx context.Context, in *DeactivateIn, opts ...grpc.CallOption) (*DeactivateOut, error)
+	// Восстановление отключенной или удаленной учетной записи
+	Reactivate(ctx context.Context, in *ReactivateIn, opts ...grpc.CallOption) (*ReactivateOut, error)
+	// Окончательное удаление сотрудника после истечения срока хранения
+	Purge(ctx context.Context, in *PurgeIn, opts ...grpc.CallOption) (*PurgeOut, error)
 	// Авторизация сотрудника по логину и паролю
 	Login(ctx context.Context, in *LoginIn, opts ...grpc.CallOption) (*LoginOut, error)
 	// Обновление токена сессии
@@ -58,6 +90,52 @@ type StaffServiceClient interface {
 	CheckAuth(ctx context.Context, in *CheckAuthIn, opts ...grpc.CallOption) (*CheckAuthOut, error)
 	// Изменение пароля авторизованного пользователя
 	ChangePassword(ctx context.Context, in *ChangePasswordIn, opts ...grpc.CallOption) (*ChangePasswordOut, error)
+	// Получение активных сессий текущего сотрудника
+	ListMySessions(ctx context.Context, in *ListMySessionsIn, opts ...grpc.CallOption) (*ListMySessionsOut, error)
+	// Завершение одной из сессий текущего сотрудника
+	RevokeSession(ctx context.Context, in *RevokeSessionIn, opts ...grpc.CallOption) (*RevokeSessionOut, error)
+	// Завершение всех сессий текущего сотрудника, кроме текущей
+	RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsIn, opts ...grpc.CallOption) (*RevokeAllOtherSessionsOut, error)
+	// Получение активных сессий сотрудника администратором
+	ListStaffSessions(ctx context.Context, in *ListStaffSessionsIn, opts ...grpc.CallOption) (*ListStaffSessionsOut, error)
+	// Завершение всех сессий сотрудника администратором
+	RevokeStaffSessions(ctx context.Context, in *RevokeStaffSessionsIn, opts ...grpc.CallOption) (*RevokeStaffSessionsOut, error)
+	// Выдача одноразового токена сброса пароля сотрудника администратором
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetIn, opts ...grpc.CallOption) (*RequestPasswordResetOut, error)
+	// Установка нового пароля по токену сброса
+	CompletePasswordReset(ctx context.Context, in *CompletePasswordResetIn, opts ...grpc.CallOption) (*CompletePasswordResetOut, error)
+	// Завершение входа вторым фактором (TOTP или резервный код)
+	VerifyMFA(ctx context.Context, in *VerifyMFAIn, opts ...grpc.CallOption) (*VerifyMFAOut, error)
+	// Начало подключения TOTP: выдача секрета и otpauth URI
+	BeginTOTPEnrollment(ctx context.Context, in *BeginTOTPEnrollmentIn, opts ...grpc.CallOption) (*BeginTOTPEnrollmentOut, error)
+	// Подтверждение подключения TOTP кодом из приложения и выдача резервных кодов
+	ConfirmTOTPEnrollment(ctx context.Context, in *ConfirmTOTPEnrollmentIn, opts ...grpc.CallOption) (*ConfirmTOTPEnrollmentOut, error)
+	// Отключение TOTP
+	DisableTOTP(ctx context.Context, in *DisableTOTPIn, opts ...grpc.CallOption) (*DisableTOTPOut, error)
+	// Получение открытых ключей для локальной проверки JWT access токенов
+	GetSigningKeys(ctx context.Context, in *GetSigningKeysIn, opts ...grpc.CallOption) (*GetSigningKeysOut, error)
+	// Получение счетчиков неудачных попыток входа и действующих блокировок
+	ListLoginLockouts(ctx context.Context, in *ListLoginLockoutsIn, opts ...grpc.CallOption) (*ListLoginLockoutsOut, error)
+	// Снятие блокировки входа по логину или адресу
+	ClearLoginLockout(ctx context.Context, in *ClearLoginLockoutIn, opts ...grpc.CallOption) (*ClearLoginLockoutOut, error)
+	// Получение списка ролей
+	ListRoles(ctx context.Context, in *ListRolesIn, opts ...grpc.CallOption) (*ListRolesOut, error)
+	// Получение информации о роли по ID
+	GetRole(ctx context.Context, in *GetRoleIn, opts ...grpc.CallOption) (*GetRoleOut, error)
+	// Создание новой роли
+	CreateRole(ctx context.Context, in *CreateRoleIn, opts ...grpc.CallOption) (*CreateRoleOut, error)
+	// Переименование роли
+	UpdateRole(ctx context.Context, in *UpdateRoleIn, opts ...grpc.CallOption) (*UpdateRoleOut, error)
+	// Удаление роли
+	DeleteRole(ctx context.Context, in *DeleteRoleIn, opts ...grpc.CallOption) (*DeleteRoleOut, error)
+	// Получение списка политик доступа к методам; метод без политики доступен только владельцу
+	ListAccessPolicies(ctx context.Context, in *ListAccessPoliciesIn, opts ...grpc.CallOption) (*ListAccessPoliciesOut, error)
+	// Создание или изменение политики доступа к методу; другие экземпляры сервиса применяют изменение в течение периода перечитывания политик
+	SetAccessPolicy(ctx context.Context, in *SetAccessPolicyIn, opts ...grpc.CallOption) (*SetAccessPolicyOut, error)
+	// Удаление политики доступа к методу, после чего метод доступен только владельцу; другие экземпляры сервиса применяют изменение в течение периода перечитывания политик
+	DeleteAccessPolicy(ctx context.Context, in *DeleteAccessPolicyIn, opts ...grpc.CallOption) (*DeleteAccessPolicyOut, error)
+	// Получение журнала аудита с фильтрацией и постраничной выдачей по курсору
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsIn, opts ...grpc.CallOption) (*ListAuditEventsOut, error)
 }
 
 type staffServiceClient struct {
@@ -118,6 +196,36 @@ func (c *staffServiceClient) List(ctx context.Context, in *ListIn, opts ...grpc.
 	return out, nil
 }
 
+func (c *staffServiceClient) Deactivate(ctx context.Context, in *DeactivateIn, opts ...grpc.CallOption) (*DeactivateOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateOut)
+	err := c.cc.Invoke(ctx, StaffService_Deactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) Reactivate(ctx context.Context, in *ReactivateIn, opts ...grpc.CallOption) (*ReactivateOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateOut)
+	err := c.cc.Invoke(ctx, StaffService_Reactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) Purge(ctx context.Context, in *PurgeIn, opts ...grpc.CallOption) (*PurgeOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeOut)
+	err := c.cc.Invoke(ctx, StaffService_Purge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *staffServiceClient) Login(ctx context.Context, in *LoginIn, opts ...grpc.CallOption) (*LoginOut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginOut)
@@ -168,6 +276,236 @@ func (c *staffServiceClient) ChangePassword(ctx context.Context, in *ChangePassw
 	return out, nil
 }
 
+func (c *staffServiceClient) ListMySessions(ctx context.Context, in *ListMySessionsIn, opts ...grpc.CallOption) (*ListMySessionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMySessionsOut)
+	err := c.cc.Invoke(ctx, StaffService_ListMySessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionIn, opts ...grpc.CallOption) (*RevokeSessionOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionOut)
+	err := c.cc.Invoke(ctx, StaffService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsIn, opts ...grpc.CallOption) (*RevokeAllOtherSessionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllOtherSessionsOut)
+	err := c.cc.Invoke(ctx, StaffService_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListStaffSessions(ctx context.Context, in *ListStaffSessionsIn, opts ...grpc.CallOption) (*ListStaffSessionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffSessionsOut)
+	err := c.cc.Invoke(ctx, StaffService_ListStaffSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RevokeStaffSessions(ctx context.Context, in *RevokeStaffSessionsIn, opts ...grpc.CallOption) (*RevokeStaffSessionsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeStaffSessionsOut)
+	err := c.cc.Invoke(ctx, StaffService_RevokeStaffSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetIn, opts ...grpc.CallOption) (*RequestPasswordResetOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetOut)
+	err := c.cc.Invoke(ctx, StaffService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) CompletePasswordReset(ctx context.Context, in *CompletePasswordResetIn, opts ...grpc.CallOption) (*CompletePasswordResetOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompletePasswordResetOut)
+	err := c.cc.Invoke(ctx, StaffService_CompletePasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) VerifyMFA(ctx context.Context, in *VerifyMFAIn, opts ...grpc.CallOption) (*VerifyMFAOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyMFAOut)
+	err := c.cc.Invoke(ctx, StaffService_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) BeginTOTPEnrollment(ctx context.Context, in *BeginTOTPEnrollmentIn, opts ...grpc.CallOption) (*BeginTOTPEnrollmentOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTOTPEnrollmentOut)
+	err := c.cc.Invoke(ctx, StaffService_BeginTOTPEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ConfirmTOTPEnrollment(ctx context.Context, in *ConfirmTOTPEnrollmentIn, opts ...grpc.CallOption) (*ConfirmTOTPEnrollmentOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTPEnrollmentOut)
+	err := c.cc.Invoke(ctx, StaffService_ConfirmTOTPEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTPIn, opts ...grpc.CallOption) (*DisableTOTPOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTPOut)
+	err := c.cc.Invoke(ctx, StaffService_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) GetSigningKeys(ctx context.Context, in *GetSigningKeysIn, opts ...grpc.CallOption) (*GetSigningKeysOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSigningKeysOut)
+	err := c.cc.Invoke(ctx, StaffService_GetSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListLoginLockouts(ctx context.Context, in *ListLoginLockoutsIn, opts ...grpc.CallOption) (*ListLoginLockoutsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginLockoutsOut)
+	err := c.cc.Invoke(ctx, StaffService_ListLoginLockouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ClearLoginLockout(ctx context.Context, in *ClearLoginLockoutIn, opts ...grpc.CallOption) (*ClearLoginLockoutOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearLoginLockoutOut)
+	err := c.cc.Invoke(ctx, StaffService_ClearLoginLockout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListRoles(ctx context.Context, in *ListRolesIn, opts ...grpc.CallOption) (*ListRolesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesOut)
+	err := c.cc.Invoke(ctx, StaffService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) GetRole(ctx context.Context, in *GetRoleIn, opts ...grpc.CallOption) (*GetRoleOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoleOut)
+	err := c.cc.Invoke(ctx, StaffService_GetRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) CreateRole(ctx context.Context, in *CreateRoleIn, opts ...grpc.CallOption) (*CreateRoleOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoleOut)
+	err := c.cc.Invoke(ctx, StaffService_CreateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleIn, opts ...grpc.CallOption) (*UpdateRoleOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoleOut)
+	err := c.cc.Invoke(ctx, StaffService_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleIn, opts ...grpc.CallOption) (*DeleteRoleOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleOut)
+	err := c.cc.Invoke(ctx, StaffService_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListAccessPolicies(ctx context.Context, in *ListAccessPoliciesIn, opts ...grpc.CallOption) (*ListAccessPoliciesOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessPoliciesOut)
+	err := c.cc.Invoke(ctx, StaffService_ListAccessPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) SetAccessPolicy(ctx context.Context, in *SetAccessPolicyIn, opts ...grpc.CallOption) (*SetAccessPolicyOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccessPolicyOut)
+	err := c.cc.Invoke(ctx, StaffService_SetAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) DeleteAccessPolicy(ctx context.Context, in *DeleteAccessPolicyIn, opts ...grpc.CallOption) (*DeleteAccessPolicyOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccessPolicyOut)
+	err := c.cc.Invoke(ctx, StaffService_DeleteAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsIn, opts ...grpc.CallOption) (*ListAuditEventsOut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsOut)
+	err := c.cc.Invoke(ctx, StaffService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StaffServiceServer is the server API for StaffService service.
 // All implementations must embed UnimplementedStaffServiceServer
 // for forward compatibility.
@@ -180,10 +518,16 @@ type StaffServiceServer interface {
 	Create(context.Context, *CreateIn) (*CreateOut, error)
 	// Обновление информации о сотруднике
 	Update(context.Context, *UpdateIn) (*UpdateOut, error)
-	// Удаление сотрудника
+	// Удаление сотрудника. Запись сохраняется до окончательного удаления методом Purge
 	Delete(context.Context, *DeleteIn) (*DeleteOut, error)
 	// Получение списка сотрудников с фильтрацией и пагинацией
 	List(context.Context, *ListIn) (*ListOut, error)
+	// Отключение учетной записи сотрудника с завершением всех его сессий
+	Deactivate(context.Context, *DeactivateIn) (*DeactivateOut, error)
+	// Восстановление отключенной или удаленной учетной записи
+	Reactivate(context.Context, *ReactivateIn) (*ReactivateOut, error)
+	// Окончательное удаление сотрудника после истечения срока хранения
+	Purge(context.Context, *PurgeIn) (*PurgeOut, error)
 	// Авторизация сотрудника по логину и паролю
 	Login(context.Context, *LoginIn) (*LoginOut, error)
 	// Обновление токена сессии
@@ -194,6 +538,52 @@ type StaffServiceServer interface {
 	CheckAuth(context.Context, *CheckAuthIn) (*CheckAuthOut, error)
 	// Изменение пароля авторизованного пользователя
 	ChangePassword(context.Context, *ChangePasswordIn) (*ChangePasswordOut, error)
+	// Получение активных сессий текущего сотрудника
+	ListMySessions(context.Context, *ListMySessionsIn) (*ListMySessionsOut, error)
+	// Завершение одной из сессий текущего сотрудника
+	RevokeSession(context.Context, *RevokeSessionIn) (*RevokeSessionOut, error)
+	// Завершение всех сессий текущего сотрудника, кроме текущей
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsIn) (*RevokeAllOtherSessionsOut, error)
+	// Получение активных сессий сотрудника администратором
+	ListStaffSessions(context.Context, *ListStaffSessionsIn) (*ListStaffSessionsOut, error)
+	// Завершение всех сессий сотрудника администратором
+	RevokeStaffSessions(context.Context, *RevokeStaffSessionsIn) (*RevokeStaffSessionsOut, error)
+	// Выдача одноразового токена сброса пароля сотрудника администратором
+	RequestPasswordReset(context.Context, *RequestPasswordResetIn) (*RequestPasswordResetOut, error)
+	// Установка нового пароля по токену сброса
+	CompletePasswordReset(context.Context, *CompletePasswordResetIn) (*CompletePasswordResetOut, error)
+	// Завершение входа вторым фактором (TOTP или резервный код)
+	VerifyMFA(context.Context, *VerifyMFAIn) (*VerifyMFAOut, error)
+	// Начало подключения TOTP: выдача секрета и otpauth URI
+	BeginTOTPEnrollment(context.Context, *BeginTOTPEnrollmentIn) (*BeginTOTPEnrollmentOut, error)
+	// Подтверждение подключения TOTP кодом из приложения и выдача резервных кодов
+	ConfirmTOTPEnrollment(context.Context, *ConfirmTOTPEnrollmentIn) (*ConfirmTOTPEnrollmentOut, error)
+	// Отключение TOTP
+	DisableTOTP(context.Context, *DisableTOTPIn) (*DisableTOTPOut, error)
+	// Получение открытых ключей для локальной проверки JWT access токенов
+	GetSigningKeys(context.Context, *GetSigningKeysIn) (*GetSigningKeysOut, error)
+	// Получение счетчиков неудачных попыток входа и действующих блокировок
+	ListLoginLockouts(context.Context, *ListLoginLockoutsIn) (*ListLoginLockoutsOut, error)
+	// Снятие блокировки входа по логину или адресу
+	ClearLoginLockout(context.Context, *ClearLoginLockoutIn) (*ClearLoginLockoutOut, error)
+	// Получение списка ролей
+	ListRoles(context.Context, *ListRolesIn) (*ListRolesOut, error)
+	// Получение информации о роли по ID
+	GetRole(context.Context, *GetRoleIn) (*GetRoleOut, error)
+	// Создание новой роли
+	CreateRole(context.Context, *CreateRoleIn) (*CreateRoleOut, error)
+	// Переименование роли
+	UpdateRole(context.Context, *UpdateRoleIn) (*UpdateRoleOut, error)
+	// Удаление роли
+	DeleteRole(context.Context, *DeleteRoleIn) (*DeleteRoleOut, error)
+	// Получение списка политик доступа к методам; метод без политики доступен только владельцу
+	ListAccessPolicies(context.Context, *ListAccessPoliciesIn) (*ListAccessPoliciesOut, error)
+	// Создание или изменение политики доступа к методу; другие экземпляры сервиса применяют изменение в течение периода перечитывания политик
+	SetAccessPolicy(context.Context, *SetAccessPolicyIn) (*SetAccessPolicyOut, error)
+	// Удаление политики доступа к методу, после чего метод доступен только владельцу; другие экземпляры сервиса применяют изменение в течение периода перечитывания политик
+	DeleteAccessPolicy(context.Context, *DeleteAccessPolicyIn) (*DeleteAccessPolicyOut, error)
+	// Получение журнала аудита с фильтрацией и постраничной выдачей по курсору
+	ListAuditEvents(context.Context, *ListAuditEventsIn) (*ListAuditEventsOut, error)
 	mustEmbedUnimplementedStaffServiceServer()
 }
 
@@ -219,6 +609,15 @@ func (UnimplementedStaffServiceServer) Delete(context.Context, *DeleteIn) (*Dele
 func (UnimplementedStaffServiceServer) List(context.Context, *ListIn) (*ListOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedStaffServiceServer) Deactivate(context.Context, *DeactivateIn) (*DeactivateOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deactivate not implemented")
+}
+func (UnimplementedStaffServiceServer) Reactivate(context.Context, *ReactivateIn) (*ReactivateOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reactivate not implemented")
+}
+func (UnimplementedStaffServiceServer) Purge(context.Context, *PurgeIn) (*PurgeOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 func (UnimplementedStaffServiceServer) Login(context.Context, *LoginIn) (*LoginOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -234,6 +633,75 @@ func (UnimplementedStaffServiceServer) CheckAuth(context.Context, *CheckAuthIn)
 func (UnimplementedStaffServiceServer) ChangePassword(context.Context, *ChangePasswordIn) (*ChangePasswordOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedStaffServiceServer) ListMySessions(context.Context, *ListMySessionsIn) (*ListMySessionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMySessions not implemented")
+}
+func (UnimplementedStaffServiceServer) RevokeSession(context.Context, *RevokeSessionIn) (*RevokeSessionOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedStaffServiceServer) RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsIn) (*RevokeAllOtherSessionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedStaffServiceServer) ListStaffSessions(context.Context, *ListStaffSessionsIn) (*ListStaffSessionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaffSessions not implemented")
+}
+func (UnimplementedStaffServiceServer) RevokeStaffSessions(context.Context, *RevokeStaffSessionsIn) (*RevokeStaffSessionsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStaffSessions not implemented")
+}
+func (UnimplementedStaffServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetIn) (*RequestPasswordResetOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedStaffServiceServer) CompletePasswordReset(context.Context, *CompletePasswordResetIn) (*CompletePasswordResetOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePasswordReset not implemented")
+}
+func (UnimplementedStaffServiceServer) VerifyMFA(context.Context, *VerifyMFAIn) (*VerifyMFAOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedStaffServiceServer) BeginTOTPEnrollment(context.Context, *BeginTOTPEnrollmentIn) (*BeginTOTPEnrollmentOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTOTPEnrollment not implemented")
+}
+func (UnimplementedStaffServiceServer) ConfirmTOTPEnrollment(context.Context, *ConfirmTOTPEnrollmentIn) (*ConfirmTOTPEnrollmentOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTPEnrollment not implemented")
+}
+func (UnimplementedStaffServiceServer) DisableTOTP(context.Context, *DisableTOTPIn) (*DisableTOTPOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedStaffServiceServer) GetSigningKeys(context.Context, *GetSigningKeysIn) (*GetSigningKeysOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSigningKeys not implemented")
+}
+func (UnimplementedStaffServiceServer) ListLoginLockouts(context.Context, *ListLoginLockoutsIn) (*ListLoginLockoutsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginLockouts not implemented")
+}
+func (UnimplementedStaffServiceServer) ClearLoginLockout(context.Context, *ClearLoginLockoutIn) (*ClearLoginLockoutOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLoginLockout not implemented")
+}
+func (UnimplementedStaffServiceServer) ListRoles(context.Context, *ListRolesIn) (*ListRolesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedStaffServiceServer) GetRole(context.Context, *GetRoleIn) (*GetRoleOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedStaffServiceServer) CreateRole(context.Context, *CreateRoleIn) (*CreateRoleOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedStaffServiceServer) UpdateRole(context.Context, *UpdateRoleIn) (*UpdateRoleOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedStaffServiceServer) DeleteRole(context.Context, *DeleteRoleIn) (*DeleteRoleOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedStaffServiceServer) ListAccessPolicies(context.Context, *ListAccessPoliciesIn) (*ListAccessPoliciesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessPolicies not implemented")
+}
+func (UnimplementedStaffServiceServer) SetAccessPolicy(context.Context, *SetAccessPolicyIn) (*SetAccessPolicyOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccessPolicy not implemented")
+}
+func (UnimplementedStaffServiceServer) DeleteAccessPolicy(context.Context, *DeleteAccessPolicyIn) (*DeleteAccessPolicyOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccessPolicy not implemented")
+}
+func (UnimplementedStaffServiceServer) ListAuditEvents(context.Context, *ListAuditEventsIn) (*ListAuditEventsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedStaffServiceServer) mustEmbedUnimplementedStaffServiceServer() {}
 func (UnimplementedStaffServiceServer) testEmbeddedByValue()                      {}
 
@@ -345,6 +813,60 @@ func _StaffService_List_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffService_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Deactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_Deactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Deactivate(ctx, req.(*DeactivateIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_Reactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Reactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_Reactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Reactivate(ctx, req.(*ReactivateIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_Purge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Purge(ctx, req.(*PurgeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StaffService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginIn)
 	if err := dec(in); err != nil {
@@ -435,15 +957,429 @@ func _StaffService_ChangePassword_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var StaffService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "staff.StaffService",
-	HandlerType: (*StaffServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
+func _StaffService_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySessionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListMySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListMySessions(ctx, req.(*ListMySessionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RevokeSession(ctx, req.(*RevokeSessionIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllOtherSessionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RevokeAllOtherSessions(ctx, req.(*RevokeAllOtherSessionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListStaffSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffSessionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListStaffSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListStaffSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListStaffSessions(ctx, req.(*ListStaffSessionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RevokeStaffSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeStaffSessionsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RevokeStaffSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RevokeStaffSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RevokeStaffSessions(ctx, req.(*RevokeStaffSessionsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_CompletePasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePasswordResetIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).CompletePasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_CompletePasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).CompletePasswordReset(ctx, req.(*CompletePasswordResetIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFAIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).VerifyMFA(ctx, req.(*VerifyMFAIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_BeginTOTPEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTOTPEnrollmentIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).BeginTOTPEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_BeginTOTPEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).BeginTOTPEnrollment(ctx, req.(*BeginTOTPEnrollmentIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ConfirmTOTPEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPEnrollmentIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ConfirmTOTPEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ConfirmTOTPEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ConfirmTOTPEnrollment(ctx, req.(*ConfirmTOTPEnrollmentIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).DisableTOTP(ctx, req.(*DisableTOTPIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_GetSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSigningKeysIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).GetSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_GetSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).GetSigningKeys(ctx, req.(*GetSigningKeysIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListLoginLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginLockoutsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListLoginLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListLoginLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListLoginLockouts(ctx, req.(*ListLoginLockoutsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ClearLoginLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLoginLockoutIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ClearLoginLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ClearLoginLockout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ClearLoginLockout(ctx, req.(*ClearLoginLockoutIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListRoles(ctx, req.(*ListRolesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_GetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).GetRole(ctx, req.(*GetRoleIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).CreateRole(ctx, req.(*CreateRoleIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).UpdateRole(ctx, req.(*UpdateRoleIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).DeleteRole(ctx, req.(*DeleteRoleIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListAccessPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessPoliciesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListAccessPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListAccessPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListAccessPolicies(ctx, req.(*ListAccessPoliciesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_SetAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccessPolicyIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).SetAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_SetAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).SetAccessPolicy(ctx, req.(*SetAccessPolicyIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_DeleteAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccessPolicyIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).DeleteAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_DeleteAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).DeleteAccessPolicy(ctx, req.(*DeleteAccessPolicyIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StaffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "staff.StaffService",
+	HandlerType: (*StaffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
 			Handler:    _StaffService_Get_Handler,
 		},
 		{
@@ -462,6 +1398,18 @@ var StaffService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _StaffService_List_Handler,
 		},
+		{
+			MethodName: "Deactivate",
+			Handler:    _StaffService_Deactivate_Handler,
+		},
+		{
+			MethodName: "Reactivate",
+			Handler:    _StaffService_Reactivate_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _StaffService_Purge_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _StaffService_Login_Handler,
@@ -482,6 +1430,98 @@ var StaffService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _StaffService_ChangePassword_Handler,
 		},
+		{
+			MethodName: "ListMySessions",
+			Handler:    _StaffService_ListMySessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _StaffService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _StaffService_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "ListStaffSessions",
+			Handler:    _StaffService_ListStaffSessions_Handler,
+		},
+		{
+			MethodName: "RevokeStaffSessions",
+			Handler:    _StaffService_RevokeStaffSessions_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _StaffService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "CompletePasswordReset",
+			Handler:    _StaffService_CompletePasswordReset_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _StaffService_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "BeginTOTPEnrollment",
+			Handler:    _StaffService_BeginTOTPEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTPEnrollment",
+			Handler:    _StaffService_ConfirmTOTPEnrollment_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _StaffService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "GetSigningKeys",
+			Handler:    _StaffService_GetSigningKeys_Handler,
+		},
+		{
+			MethodName: "ListLoginLockouts",
+			Handler:    _StaffService_ListLoginLockouts_Handler,
+		},
+		{
+			MethodName: "ClearLoginLockout",
+			Handler:    _StaffService_ClearLoginLockout_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _StaffService_ListRoles_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _StaffService_GetRole_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _StaffService_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _StaffService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _StaffService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListAccessPolicies",
+			Handler:    _StaffService_ListAccessPolicies_Handler,
+		},
+		{
+			MethodName: "SetAccessPolicy",
+			Handler:    _StaffService_SetAccessPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteAccessPolicy",
+			Handler:    _StaffService_DeleteAccessPolicy_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _StaffService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/staff.proto",